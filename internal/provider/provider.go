@@ -2,13 +2,23 @@ package provider
 
 import (
 	"context"
+	"terraform-provider-azuresql/internal/services/asymmetric_key"
+	"terraform-provider-azuresql/internal/services/certificate"
+	"terraform-provider-azuresql/internal/services/column_encryption_key"
+	"terraform-provider-azuresql/internal/services/column_master_key"
+	"terraform-provider-azuresql/internal/services/data_mask"
 	"terraform-provider-azuresql/internal/services/database"
+	"terraform-provider-azuresql/internal/services/database_options"
 	"terraform-provider-azuresql/internal/services/database_scoped_credential"
 	"terraform-provider-azuresql/internal/services/execute_sql"
 	"terraform-provider-azuresql/internal/services/external_data_source"
+	"terraform-provider-azuresql/internal/services/external_file_format"
+	"terraform-provider-azuresql/internal/services/external_table"
 	"terraform-provider-azuresql/internal/services/fabricworkspace"
 	"terraform-provider-azuresql/internal/services/function"
 	"terraform-provider-azuresql/internal/services/master_key"
+	"terraform-provider-azuresql/internal/services/migrations"
+	"terraform-provider-azuresql/internal/services/module_signature"
 	"terraform-provider-azuresql/internal/services/permission"
 	"terraform-provider-azuresql/internal/services/procedure"
 	"terraform-provider-azuresql/internal/services/role"
@@ -16,11 +26,17 @@ import (
 	dbschema "terraform-provider-azuresql/internal/services/schema"
 	"terraform-provider-azuresql/internal/services/securitypolicy"
 	"terraform-provider-azuresql/internal/services/securitypredicate"
+	"terraform-provider-azuresql/internal/services/sensitivity_classification"
+	"terraform-provider-azuresql/internal/services/sequence"
 	login "terraform-provider-azuresql/internal/services/sqllogin"
 	"terraform-provider-azuresql/internal/services/sqlserver"
 	"terraform-provider-azuresql/internal/services/synapseserver"
+	"terraform-provider-azuresql/internal/services/synonym"
 	"terraform-provider-azuresql/internal/services/table"
+	"terraform-provider-azuresql/internal/services/table_rows"
+	"terraform-provider-azuresql/internal/services/trigger"
 	"terraform-provider-azuresql/internal/services/user"
+	"terraform-provider-azuresql/internal/services/usertype"
 	"terraform-provider-azuresql/internal/services/view"
 	"terraform-provider-azuresql/internal/sql"
 
@@ -142,8 +158,24 @@ func (p *azuresql_provider) Resources(_ context.Context) []func() resource.Resou
 		master_key.NewMasterKeyResource,
 		database_scoped_credential.NewDatabaseScopedCredentialResource,
 		external_data_source.NewExternalDataSourceResource,
+		external_file_format.NewExternalFileFormatResource,
+		external_table.NewExternalTableResource,
+		certificate.NewCertificateResource,
+		asymmetric_key.NewAsymmetricKeyResource,
+		module_signature.NewModuleSignatureResource,
+		column_master_key.NewColumnMasterKeyResource,
+		column_encryption_key.NewColumnEncryptionKeyResource,
+		data_mask.NewDataMaskResource,
+		sensitivity_classification.NewSensitivityClassificationResource,
+		database_options.NewDatabaseOptionsResource,
 		view.NewViewResource,
 		database.NewDatabaseResource,
 		procedure.NewProcedureResource,
+		migrations.NewMigrationsResource,
+		table_rows.NewTableRowsResource,
+		sequence.NewSequenceResource,
+		synonym.NewSynonymResource,
+		trigger.NewTriggerResource,
+		usertype.NewTypeResource,
 	}
 }
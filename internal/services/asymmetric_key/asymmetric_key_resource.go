@@ -0,0 +1,266 @@
+package asymmetric_key
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AsymmetricKeyResource{}
+	_ resource.ResourceWithConfigure   = &AsymmetricKeyResource{}
+	_ resource.ResourceWithImportState = &AsymmetricKeyResource{}
+)
+
+func NewAsymmetricKeyResource() resource.Resource {
+	return &AsymmetricKeyResource{}
+}
+
+type AsymmetricKeyResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *AsymmetricKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asymmetric_key"
+}
+
+func (r *AsymmetricKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Database asymmetric key generated by SQL.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database where the asymmetric key should be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"asymmetric_key_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the asymmetric key in the database",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the asymmetric key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"algorithm": schema.StringAttribute{
+				Required:    true,
+				Description: "Algorithm of the key. Possible values are `RSA_2048`, `RSA_3072` and `RSA_4096`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("RSA_2048", "RSA_3072", "RSA_4096"),
+				},
+			},
+			"thumbprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-1 thumbprint of the asymmetric key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func setState(state *AsymmetricKeyResourceModel, asymmetricKey sql.AsymmetricKey) {
+	state.Id = types.StringValue(asymmetricKey.Id)
+	state.AsymmetricKeyId = types.Int64Value(asymmetricKey.AsymmetricKeyId)
+	state.Name = types.StringValue(asymmetricKey.Name)
+	state.Algorithm = types.StringValue(asymmetricKey.Algorithm)
+	state.Thumbprint = types.StringValue(asymmetricKey.Thumbprint)
+}
+
+func (r *AsymmetricKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan AsymmetricKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider != "sqlserver" {
+		logging.AddError(ctx, "Invalid config",
+			"`azuresql_asymmetric_key` resource is only supported on SQL databases.")
+		return
+	}
+
+	asymmetricKey := sql.CreateAsymmetricKey(ctx, connection, name, plan.Algorithm.ValueString())
+
+	if logging.HasError(ctx) {
+		if asymmetricKey.Id != "" {
+			logging.AddError(
+				ctx,
+				"Asymmetric key already exists",
+				fmt.Sprintf("You can import this resource using `terraform import azuresql_asymmetric_key.<name> %s", asymmetricKey.Id))
+		}
+		return
+	}
+
+	setState(&plan, asymmetricKey)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AsymmetricKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state AsymmetricKeyResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	asymmetricKey := sql.GetAsymmetricKeyFromId(ctx, connection, state.Id.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if asymmetricKey.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setState(&state, asymmetricKey)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AsymmetricKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *AsymmetricKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+}
+
+func (r *AsymmetricKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state AsymmetricKeyResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	sql.DropAsymmetricKey(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Dropping asymmetric key failed", fmt.Sprintf("Dropping asymmetric key %s failed", state.Name.ValueString()))
+	}
+}
+
+func (r *AsymmetricKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing asymmetric key %s", req.ID))
+
+	asymmetricKey := sql.ParseAsymmetricKeyId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, asymmetricKey.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	asymmetricKey = sql.GetAsymmetricKeyFromId(ctx, connection, req.ID, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := AsymmetricKeyResourceModel{
+		Database: types.StringValue(asymmetricKey.Connection),
+	}
+	setState(&state, asymmetricKey)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,66 @@
+package asymmetric_key_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type AsymmetricKeyResource struct{}
+
+func TestAccCreateAsymmetricKey(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := AsymmetricKeyResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check:                    resource.TestCheckResourceAttr("azuresql_asymmetric_key.test", "algorithm", "RSA_2048"),
+				},
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_asymmetric_key.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func (r AsymmetricKeyResource) basic(connection string, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_asymmetric_key" "test" {
+		database 	= "%[2]s"
+		name 		= "tfkey_%[3]s"
+		algorithm 	= "RSA_2048"
+
+		depends_on = [azuresql_master_key.test]
+	}
+`, r.template(connection), connection, name)
+}
+
+func (r AsymmetricKeyResource) template(connection string) string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+
+		resource "azuresql_master_key" "test" {
+			database 	= "%[1]s"
+		}
+	`, connection)
+}
@@ -0,0 +1,14 @@
+package asymmetric_key
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AsymmetricKeyResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Database        types.String `tfsdk:"database"`
+	AsymmetricKeyId types.Int64  `tfsdk:"asymmetric_key_id"`
+	Name            types.String `tfsdk:"name"`
+	Algorithm       types.String `tfsdk:"algorithm"`
+	Thumbprint      types.String `tfsdk:"thumbprint"`
+}
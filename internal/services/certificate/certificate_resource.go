@@ -0,0 +1,336 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &CertificateResource{}
+	_ resource.ResourceWithConfigure   = &CertificateResource{}
+	_ resource.ResourceWithImportState = &CertificateResource{}
+)
+
+func NewCertificateResource() resource.Resource {
+	return &CertificateResource{}
+}
+
+type CertificateResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *CertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+var dateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+func (r *CertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Database certificate.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database where the certificate should be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the certificate in the database",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the certificate",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subject": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Subject of a certificate generated by SQL. Either `subject` or `certificate_wo` should be specified.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("certificate_wo")),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Date from which a generated certificate is valid, formatted as `YYYY-MM-DD`. Defaults to the current date.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex, "should be formatted as YYYY-MM-DD"),
+					stringvalidator.ConflictsWith(path.MatchRoot("certificate_wo")),
+				},
+			},
+			"expiry_date": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Date on which a generated certificate expires, formatted as `YYYY-MM-DD`. Defaults to one year after the start date.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex, "should be formatted as YYYY-MM-DD"),
+					stringvalidator.ConflictsWith(path.MatchRoot("certificate_wo")),
+				},
+			},
+			"certificate_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Description: "Existing certificate to import, either as PEM with an optional RSA private key or as a base64 encoded PFX.",
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Description: "Password of the PFX specified in `certificate_wo`.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("certificate_wo")),
+				},
+			},
+			"certificate_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of certificate_wo. Changing the version recreates the certificate.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("certificate_wo")),
+				},
+			},
+			"thumbprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-1 thumbprint of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func setState(state *CertificateResourceModel, certificate sql.Certificate) {
+	state.Id = types.StringValue(certificate.Id)
+	state.CertificateId = types.Int64Value(certificate.CertificateId)
+	state.Name = types.StringValue(certificate.Name)
+	state.Subject = types.StringValue(certificate.Subject)
+	state.StartDate = types.StringValue(certificate.StartDate)
+	state.ExpiryDate = types.StringValue(certificate.ExpiryDate)
+	state.Thumbprint = types.StringValue(certificate.Thumbprint)
+}
+
+func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan, config CertificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider != "sqlserver" {
+		logging.AddError(ctx, "Invalid config",
+			"`azuresql_certificate` resource is only supported on SQL databases.")
+		return
+	}
+
+	// write-only values are only available in the config
+	certificate := sql.CreateCertificate(ctx, connection, name,
+		sql.Certificate{
+			Subject:    config.Subject.ValueString(),
+			StartDate:  config.StartDate.ValueString(),
+			ExpiryDate: config.ExpiryDate.ValueString(),
+		},
+		config.CertificateWo.ValueString(),
+		config.PasswordWo.ValueString())
+
+	if logging.HasError(ctx) {
+		if certificate.Id != "" {
+			logging.AddError(
+				ctx,
+				"Certificate already exists",
+				fmt.Sprintf("You can import this resource using `terraform import azuresql_certificate.<name> %s", certificate.Id))
+		}
+		return
+	}
+
+	setState(&plan, certificate)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state CertificateResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	certificate := sql.GetCertificateFromId(ctx, connection, state.Id.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if certificate.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setState(&state, certificate)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+}
+
+func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state CertificateResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	sql.DropCertificate(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Dropping certificate failed", fmt.Sprintf("Dropping certificate %s failed", state.Name.ValueString()))
+	}
+}
+
+func (r *CertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing certificate %s", req.ID))
+
+	certificate := sql.ParseCertificateId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, certificate.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	certificate = sql.GetCertificateFromId(ctx, connection, req.ID, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := CertificateResourceModel{
+		Database: types.StringValue(certificate.Connection),
+	}
+	setState(&state, certificate)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
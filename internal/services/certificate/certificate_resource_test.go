@@ -0,0 +1,144 @@
+package certificate_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type CertificateResource struct{}
+
+func TestAccCreateCertificateGenerated(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := CertificateResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.generated(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("azuresql_certificate.test", "expiry_date", "2040-01-01"),
+						resource.TestCheckResourceAttrSet("azuresql_certificate.test", "thumbprint"),
+					),
+				},
+				{
+					Config:                   r.generated(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_certificate.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func TestAccCreateCertificateFromPem(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := CertificateResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.pem(connection, data.RandomString, r.generatePem(t), 1),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check:                    resource.TestCheckResourceAttr("azuresql_certificate.test", "subject", "tfcertificate"),
+				},
+				{
+					// a new version recreates the certificate
+					Config:                   r.pem(connection, data.RandomString, r.generatePem(t), 2),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+			},
+		})
+	}
+}
+
+func (r CertificateResource) generatePem(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "tfcertificate"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+func (r CertificateResource) generated(connection string, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_certificate" "test" {
+		database 	= "%[2]s"
+		name 		= "tfcertificate_%[3]s"
+		subject 	= "Terraform test certificate"
+		expiry_date = "2040-01-01"
+
+		depends_on = [azuresql_master_key.test]
+	}
+`, r.template(connection), connection, name)
+}
+
+func (r CertificateResource) pem(connection string, name string, pem string, version int) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_certificate" "test" {
+		database 				= "%[2]s"
+		name 					= "tfcertificate_%[3]s"
+		certificate_wo 			= <<-EOT
+%[4]s
+		EOT
+		certificate_wo_version 	= %[5]d
+
+		depends_on = [azuresql_master_key.test]
+	}
+`, r.template(connection), connection, name, pem, version)
+}
+
+func (r CertificateResource) template(connection string) string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+
+		resource "azuresql_master_key" "test" {
+			database 	= "%[1]s"
+		}
+	`, connection)
+}
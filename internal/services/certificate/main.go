@@ -0,0 +1,19 @@
+package certificate
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CertificateResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Database             types.String `tfsdk:"database"`
+	CertificateId        types.Int64  `tfsdk:"certificate_id"`
+	Name                 types.String `tfsdk:"name"`
+	Subject              types.String `tfsdk:"subject"`
+	StartDate            types.String `tfsdk:"start_date"`
+	ExpiryDate           types.String `tfsdk:"expiry_date"`
+	CertificateWo        types.String `tfsdk:"certificate_wo"`
+	PasswordWo           types.String `tfsdk:"password_wo"`
+	CertificateWoVersion types.Int64  `tfsdk:"certificate_wo_version"`
+	Thumbprint           types.String `tfsdk:"thumbprint"`
+}
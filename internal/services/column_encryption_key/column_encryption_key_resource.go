@@ -0,0 +1,407 @@
+package column_encryption_key
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ColumnEncryptionKeyResource{}
+	_ resource.ResourceWithConfigure      = &ColumnEncryptionKeyResource{}
+	_ resource.ResourceWithImportState    = &ColumnEncryptionKeyResource{}
+	_ resource.ResourceWithValidateConfig = &ColumnEncryptionKeyResource{}
+	_ resource.ResourceWithModifyPlan     = &ColumnEncryptionKeyResource{}
+)
+
+var encryptedValueRegex = regexp.MustCompile(`^0x[0-9A-Fa-f]+$`)
+
+func NewColumnEncryptionKeyResource() resource.Resource {
+	return &ColumnEncryptionKeyResource{}
+}
+
+type ColumnEncryptionKeyResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *ColumnEncryptionKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_column_encryption_key"
+}
+
+func (r *ColumnEncryptionKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Always Encrypted column encryption key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database where the column encryption key should be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"column_encryption_key_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the column encryption key in the database",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the column encryption key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"values": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Encrypted values of the column encryption key, one per column master key. A second value is only used while rotating the column master key.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 2),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"column_master_key": schema.StringAttribute{
+							Required:    true,
+							Description: "Id of the azuresql_column_master_key encrypting the value.",
+						},
+						"algorithm": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("RSA_OAEP"),
+							Description: "Algorithm used to encrypt the value. The only supported value is `RSA_OAEP`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("RSA_OAEP"),
+							},
+						},
+						"encrypted_value": schema.StringAttribute{
+							Required:    true,
+							Description: "Column encryption key encrypted with the column master key, as a binary constant.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(encryptedValueRegex, "Encrypted value should be a binary constant, e.g. 0x01AB"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ColumnEncryptionKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var data ColumnEncryptionKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.Values) == 2 &&
+		!data.Values[0].ColumnMasterKey.IsUnknown() &&
+		data.Values[0].ColumnMasterKey.Equal(data.Values[1].ColumnMasterKey) {
+		logging.AddAttributeError(ctx, path.Root("values"), "Invalid attribute configuration",
+			"values should be encrypted by different column master keys")
+	}
+}
+
+func (r ColumnEncryptionKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	// no modification required on create or delete
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan ColumnEncryptionKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// values can only be added or dropped
+	// -> a replace is required when the value of an existing column master key changes
+	for _, planValue := range plan.Values {
+		for _, stateValue := range state.Values {
+			if planValue.ColumnMasterKey.Equal(stateValue.ColumnMasterKey) &&
+				(!planValue.Algorithm.Equal(stateValue.Algorithm) ||
+					!sql.IsBinaryEquivalent(planValue.EncryptedValue.ValueString(), stateValue.EncryptedValue.ValueString())) {
+				resp.RequiresReplace.Append(path.Root("values"))
+				return
+			}
+		}
+	}
+}
+
+func toSqlValues(values []ColumnEncryptionKeyValueResourceModel) (sqlValues []sql.ColumnEncryptionKeyValue) {
+	for _, value := range values {
+		sqlValues = append(sqlValues, sql.ColumnEncryptionKeyValue{
+			ColumnMasterKey: value.ColumnMasterKey.ValueString(),
+			Algorithm:       value.Algorithm.ValueString(),
+			EncryptedValue:  value.EncryptedValue.ValueString(),
+		})
+	}
+	return
+}
+
+func setState(state *ColumnEncryptionKeyResourceModel, columnEncryptionKey sql.ColumnEncryptionKey) {
+	state.Id = types.StringValue(columnEncryptionKey.Id)
+	state.ColumnEncryptionKeyId = types.Int64Value(columnEncryptionKey.ColumnEncryptionKeyId)
+	state.Name = types.StringValue(columnEncryptionKey.Name)
+
+	// keep the order and notation of the values in the current state
+	var values []ColumnEncryptionKeyValueResourceModel
+	used := make([]bool, len(columnEncryptionKey.Values))
+	for _, stateValue := range state.Values {
+		for i, value := range columnEncryptionKey.Values {
+			if !used[i] && stateValue.ColumnMasterKey.ValueString() == value.ColumnMasterKey {
+				used[i] = true
+				if !sql.IsBinaryEquivalent(stateValue.EncryptedValue.ValueString(), value.EncryptedValue) {
+					stateValue.EncryptedValue = types.StringValue(value.EncryptedValue)
+				}
+				stateValue.Algorithm = types.StringValue(value.Algorithm)
+				values = append(values, stateValue)
+			}
+		}
+	}
+	for i, value := range columnEncryptionKey.Values {
+		if !used[i] {
+			values = append(values, ColumnEncryptionKeyValueResourceModel{
+				ColumnMasterKey: types.StringValue(value.ColumnMasterKey),
+				Algorithm:       types.StringValue(value.Algorithm),
+				EncryptedValue:  types.StringValue(value.EncryptedValue),
+			})
+		}
+	}
+	state.Values = values
+}
+
+func (r *ColumnEncryptionKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan ColumnEncryptionKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider != "sqlserver" {
+		logging.AddError(ctx, "Invalid config",
+			"`azuresql_column_encryption_key` resource is only supported on SQL databases.")
+		return
+	}
+
+	columnEncryptionKey := sql.CreateColumnEncryptionKey(ctx, connection, name, toSqlValues(plan.Values))
+
+	if logging.HasError(ctx) {
+		if columnEncryptionKey.Id != "" {
+			logging.AddError(
+				ctx,
+				"Column encryption key already exists",
+				fmt.Sprintf("You can import this resource using `terraform import azuresql_column_encryption_key.<name> %s", columnEncryptionKey.Id))
+		}
+		return
+	}
+
+	setState(&plan, columnEncryptionKey)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ColumnEncryptionKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state ColumnEncryptionKeyResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	columnEncryptionKey := sql.GetColumnEncryptionKeyFromId(ctx, connection, state.Id.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if columnEncryptionKey.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setState(&state, columnEncryptionKey)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ColumnEncryptionKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *ColumnEncryptionKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan ColumnEncryptionKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	sql.UpdateColumnEncryptionKey(ctx, connection, plan.Id.ValueString(), toSqlValues(plan.Values))
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	columnEncryptionKey := sql.GetColumnEncryptionKeyFromId(ctx, connection, plan.Id.ValueString(), true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	setState(&plan, columnEncryptionKey)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ColumnEncryptionKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state ColumnEncryptionKeyResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	sql.DropColumnEncryptionKey(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Dropping column encryption key failed", fmt.Sprintf("Dropping column encryption key %s failed", state.Name.ValueString()))
+	}
+}
+
+func (r *ColumnEncryptionKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing column encryption key %s", req.ID))
+
+	columnEncryptionKey := sql.ParseColumnEncryptionKeyId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, columnEncryptionKey.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	columnEncryptionKey = sql.GetColumnEncryptionKeyFromId(ctx, connection, req.ID, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := ColumnEncryptionKeyResourceModel{
+		Database: types.StringValue(columnEncryptionKey.Connection),
+	}
+	setState(&state, columnEncryptionKey)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
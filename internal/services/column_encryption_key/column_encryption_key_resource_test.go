@@ -0,0 +1,159 @@
+package column_encryption_key_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type ColumnEncryptionKeyResource struct{}
+
+// Encrypted values are opaque to SQL, no real column master key is required
+const encryptedValue1 = "0x016E000001630075007200720065006E00740075007300650072002F006D0079002F0037003800310038003600650031003500"
+const encryptedValue2 = "0x016E000001630075007200720065006E00740075007300650072002F006D0079002F0038003200360039003200660064003000"
+
+func TestAccCreateColumnEncryptionKey(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := ColumnEncryptionKeyResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("azuresql_column_encryption_key.test", "values.#", "1"),
+						resource.TestCheckResourceAttr("azuresql_column_encryption_key.test", "values.0.algorithm", "RSA_OAEP"),
+					),
+				},
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_column_encryption_key.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func TestAccRotateColumnEncryptionKey(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := ColumnEncryptionKeyResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.rotating(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check:                    resource.TestCheckResourceAttr("azuresql_column_encryption_key.test", "values.#", "2"),
+				},
+				{
+					Config:                   r.rotated(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("azuresql_column_encryption_key.test", "values.#", "1"),
+						resource.TestCheckResourceAttrPair("azuresql_column_encryption_key.test", "values.0.column_master_key", "azuresql_column_master_key.new", "id"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func (r ColumnEncryptionKeyResource) basic(connection string, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_column_encryption_key" "test" {
+		database 	= "%[2]s"
+		name 		= "tfcek_%[3]s"
+		values 		= [
+			{
+				column_master_key 	= azuresql_column_master_key.old.id
+				encrypted_value 	= "%[4]s"
+			}
+		]
+	}
+`, r.template(connection, name), connection, name, encryptedValue1)
+}
+
+func (r ColumnEncryptionKeyResource) rotating(connection string, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_column_encryption_key" "test" {
+		database 	= "%[2]s"
+		name 		= "tfcek_%[3]s"
+		values 		= [
+			{
+				column_master_key 	= azuresql_column_master_key.old.id
+				encrypted_value 	= "%[4]s"
+			},
+			{
+				column_master_key 	= azuresql_column_master_key.new.id
+				encrypted_value 	= "%[5]s"
+			}
+		]
+	}
+`, r.template(connection, name), connection, name, encryptedValue1, encryptedValue2)
+}
+
+func (r ColumnEncryptionKeyResource) rotated(connection string, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_column_encryption_key" "test" {
+		database 	= "%[2]s"
+		name 		= "tfcek_%[3]s"
+		values 		= [
+			{
+				column_master_key 	= azuresql_column_master_key.new.id
+				encrypted_value 	= "%[4]s"
+			}
+		]
+	}
+`, r.template(connection, name), connection, name, encryptedValue2)
+}
+
+func (r ColumnEncryptionKeyResource) template(connection string, name string) string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+
+		resource "azuresql_column_master_key" "old" {
+			database 				= "%[1]s"
+			name 					= "tfcmk_old_%[2]s"
+			key_store_provider_name	= "AZURE_KEY_VAULT"
+			key_path 				= "https://tfvault%[2]s.vault.azure.net/keys/cmk/00000000000000000000000000000001"
+		}
+
+		resource "azuresql_column_master_key" "new" {
+			database 				= "%[1]s"
+			name 					= "tfcmk_new_%[2]s"
+			key_store_provider_name	= "AZURE_KEY_VAULT"
+			key_path 				= "https://tfvault%[2]s.vault.azure.net/keys/cmk/00000000000000000000000000000002"
+		}
+	`, connection, name)
+}
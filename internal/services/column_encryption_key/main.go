@@ -0,0 +1,19 @@
+package column_encryption_key
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ColumnEncryptionKeyValueResourceModel struct {
+	ColumnMasterKey types.String `tfsdk:"column_master_key"`
+	Algorithm       types.String `tfsdk:"algorithm"`
+	EncryptedValue  types.String `tfsdk:"encrypted_value"`
+}
+
+type ColumnEncryptionKeyResourceModel struct {
+	Id                    types.String                            `tfsdk:"id"`
+	Database              types.String                            `tfsdk:"database"`
+	ColumnEncryptionKeyId types.Int64                             `tfsdk:"column_encryption_key_id"`
+	Name                  types.String                            `tfsdk:"name"`
+	Values                []ColumnEncryptionKeyValueResourceModel `tfsdk:"values"`
+}
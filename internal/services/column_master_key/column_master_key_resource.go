@@ -0,0 +1,300 @@
+package column_master_key
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ColumnMasterKeyResource{}
+	_ resource.ResourceWithConfigure   = &ColumnMasterKeyResource{}
+	_ resource.ResourceWithImportState = &ColumnMasterKeyResource{}
+)
+
+var signatureRegex = regexp.MustCompile(`^0x[0-9A-Fa-f]+$`)
+
+func NewColumnMasterKeyResource() resource.Resource {
+	return &ColumnMasterKeyResource{}
+}
+
+type ColumnMasterKeyResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *ColumnMasterKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_column_master_key"
+}
+
+func (r *ColumnMasterKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Always Encrypted column master key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database where the column master key should be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"column_master_key_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the column master key in the database",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the column master key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_store_provider_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the key store provider, e.g. `AZURE_KEY_VAULT` or `MSSQL_CERTIFICATE_STORE`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_path": schema.StringAttribute{
+				Required:    true,
+				Description: "Path of the key in the key store, e.g. the versioned key identifier in Azure Key Vault.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"signature": schema.StringAttribute{
+				Optional:    true,
+				Description: "Signature of the key path and enclave computations setting, signed with the column master key. Specifying a signature allows enclave computations.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(signatureRegex, "Signature should be a binary constant, e.g. 0x01AB"),
+				},
+			},
+			"allow_enclave_computations": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Indicates whether the column master key allows enclave computations.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func setState(state *ColumnMasterKeyResourceModel, columnMasterKey sql.ColumnMasterKey) {
+	state.Id = types.StringValue(columnMasterKey.Id)
+	state.ColumnMasterKeyId = types.Int64Value(columnMasterKey.ColumnMasterKeyId)
+	state.Name = types.StringValue(columnMasterKey.Name)
+	state.KeyStoreProviderName = types.StringValue(columnMasterKey.KeyStoreProviderName)
+	state.KeyPath = types.StringValue(columnMasterKey.KeyPath)
+	state.AllowEnclaveComputations = types.BoolValue(columnMasterKey.AllowEnclaveComputations)
+
+	// keep the configured notation of the signature
+	if columnMasterKey.Signature == "" {
+		state.Signature = types.StringNull()
+	} else if !sql.IsBinaryEquivalent(state.Signature.ValueString(), columnMasterKey.Signature) {
+		state.Signature = types.StringValue(columnMasterKey.Signature)
+	}
+}
+
+func (r *ColumnMasterKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan ColumnMasterKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider != "sqlserver" {
+		logging.AddError(ctx, "Invalid config",
+			"`azuresql_column_master_key` resource is only supported on SQL databases.")
+		return
+	}
+
+	columnMasterKey := sql.CreateColumnMasterKey(ctx, connection, name, sql.ColumnMasterKey{
+		KeyStoreProviderName: plan.KeyStoreProviderName.ValueString(),
+		KeyPath:              plan.KeyPath.ValueString(),
+		Signature:            plan.Signature.ValueString(),
+	})
+
+	if logging.HasError(ctx) {
+		if columnMasterKey.Id != "" {
+			logging.AddError(
+				ctx,
+				"Column master key already exists",
+				fmt.Sprintf("You can import this resource using `terraform import azuresql_column_master_key.<name> %s", columnMasterKey.Id))
+		}
+		return
+	}
+
+	setState(&plan, columnMasterKey)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ColumnMasterKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state ColumnMasterKeyResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	columnMasterKey := sql.GetColumnMasterKeyFromId(ctx, connection, state.Id.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if columnMasterKey.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setState(&state, columnMasterKey)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ColumnMasterKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *ColumnMasterKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+}
+
+func (r *ColumnMasterKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state ColumnMasterKeyResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	sql.DropColumnMasterKey(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Dropping column master key failed", fmt.Sprintf("Dropping column master key %s failed", state.Name.ValueString()))
+	}
+}
+
+func (r *ColumnMasterKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing column master key %s", req.ID))
+
+	columnMasterKey := sql.ParseColumnMasterKeyId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, columnMasterKey.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	columnMasterKey = sql.GetColumnMasterKeyFromId(ctx, connection, req.ID, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := ColumnMasterKeyResourceModel{
+		Database: types.StringValue(columnMasterKey.Connection),
+	}
+	setState(&state, columnMasterKey)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
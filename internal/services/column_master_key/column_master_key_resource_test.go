@@ -0,0 +1,77 @@
+package column_master_key_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type ColumnMasterKeyResource struct{}
+
+func TestAccCreateColumnMasterKey(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := ColumnMasterKeyResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("azuresql_column_master_key.test", "key_store_provider_name", "AZURE_KEY_VAULT"),
+						resource.TestCheckResourceAttr("azuresql_column_master_key.test", "allow_enclave_computations", "false"),
+						resource.TestCheckResourceAttr("azuresql_permission.test", "permission", "view any column master key definition"),
+					),
+				},
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_column_master_key.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func (r ColumnMasterKeyResource) basic(connection string, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_column_master_key" "test" {
+		database 				= "%[2]s"
+		name 					= "tfcmk_%[3]s"
+		key_store_provider_name	= "AZURE_KEY_VAULT"
+		key_path 				= "https://tfvault%[3]s.vault.azure.net/keys/cmk/0123456789abcdef0123456789abcdef"
+	}
+
+	resource "azuresql_role" "test" {
+		database 	= "%[2]s"
+		name 		= "tfrole_%[3]s"
+	}
+
+	resource "azuresql_permission" "test" {
+		database 	= "%[2]s"
+		scope 		= "%[2]s"
+		principal 	= azuresql_role.test.id
+		permission 	= "view any column master key definition"
+	}
+`, r.template(), connection, name)
+}
+
+func (r ColumnMasterKeyResource) template() string {
+	return `
+		provider "azuresql" {
+		}
+	`
+}
@@ -0,0 +1,16 @@
+package column_master_key
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ColumnMasterKeyResourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	Database                 types.String `tfsdk:"database"`
+	ColumnMasterKeyId        types.Int64  `tfsdk:"column_master_key_id"`
+	Name                     types.String `tfsdk:"name"`
+	KeyStoreProviderName     types.String `tfsdk:"key_store_provider_name"`
+	KeyPath                  types.String `tfsdk:"key_path"`
+	Signature                types.String `tfsdk:"signature"`
+	AllowEnclaveComputations types.Bool   `tfsdk:"allow_enclave_computations"`
+}
@@ -0,0 +1,416 @@
+package data_mask
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &DataMaskResource{}
+	_ resource.ResourceWithConfigure      = &DataMaskResource{}
+	_ resource.ResourceWithImportState    = &DataMaskResource{}
+	_ resource.ResourceWithValidateConfig = &DataMaskResource{}
+)
+
+func NewDataMaskResource() resource.Resource {
+	return &DataMaskResource{}
+}
+
+type DataMaskResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *DataMaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_mask"
+}
+
+func (r *DataMaskResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Dynamic data mask on a table column.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database of the masked table.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the azuresql_table containing the masked column.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"column": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the masked column.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"column_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the masked column in the table.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"column_scope": schema.StringAttribute{
+				Computed:    true,
+				Description: "Id of the masked column, to be used as scope of an azuresql_permission, e.g. to grant `unmask` on the column.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"function": schema.StringAttribute{
+				Required:    true,
+				Description: "Masking function. Possible values are `default`, `email`, `partial` and `random`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "email", "partial", "random"),
+				},
+			},
+			"prefix": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of leading characters exposed by the `partial` function.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"padding": schema.StringAttribute{
+				Optional:    true,
+				Description: "Padding string of the `partial` function.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^"]*$`), "padding can't contain double quotes"),
+				},
+			},
+			"suffix": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of trailing characters exposed by the `partial` function.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"start": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Start of the range of the `random` function.",
+			},
+			"end": schema.Float64Attribute{
+				Optional:    true,
+				Description: "End of the range of the `random` function.",
+			},
+		},
+	}
+}
+
+func (r DataMaskResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var data DataMaskResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Function.IsUnknown() {
+		return
+	}
+
+	function := data.Function.ValueString()
+	arguments := []struct {
+		name     string
+		function string
+		isSet    bool
+	}{
+		{"prefix", "partial", !data.Prefix.IsNull()},
+		{"padding", "partial", !data.Padding.IsNull()},
+		{"suffix", "partial", !data.Suffix.IsNull()},
+		{"start", "random", !data.Start.IsNull()},
+		{"end", "random", !data.End.IsNull()},
+	}
+
+	for _, argument := range arguments {
+		if argument.isSet != (function == argument.function) {
+			logging.AddAttributeError(ctx, path.Root(argument.name), "Invalid attribute configuration",
+				fmt.Sprintf("%s is required when function equals `%s` and not allowed otherwise", argument.name, argument.function))
+		}
+	}
+}
+
+func toSqlDataMask(plan DataMaskResourceModel) sql.DataMask {
+	return sql.DataMask{
+		Table:    plan.Table.ValueString(),
+		Column:   plan.Column.ValueString(),
+		Function: plan.Function.ValueString(),
+		Prefix:   plan.Prefix.ValueInt64(),
+		Padding:  plan.Padding.ValueString(),
+		Suffix:   plan.Suffix.ValueInt64(),
+		Start:    plan.Start.ValueFloat64(),
+		End:      plan.End.ValueFloat64(),
+	}
+}
+
+func setState(state *DataMaskResourceModel, dataMask sql.DataMask) {
+	state.Id = types.StringValue(dataMask.Id)
+	state.Table = types.StringValue(dataMask.Table)
+	state.Column = types.StringValue(dataMask.Column)
+	state.ColumnId = types.Int64Value(dataMask.ColumnId)
+	state.ColumnScope = types.StringValue(sql.DataMaskColumnId(dataMask))
+	state.Function = types.StringValue(dataMask.Function)
+
+	state.Prefix = types.Int64Null()
+	state.Padding = types.StringNull()
+	state.Suffix = types.Int64Null()
+	state.Start = types.Float64Null()
+	state.End = types.Float64Null()
+
+	if dataMask.Function == "partial" {
+		state.Prefix = types.Int64Value(dataMask.Prefix)
+		state.Padding = types.StringValue(dataMask.Padding)
+		state.Suffix = types.Int64Value(dataMask.Suffix)
+	}
+
+	if dataMask.Function == "random" {
+		state.Start = types.Float64Value(dataMask.Start)
+		state.End = types.Float64Value(dataMask.End)
+	}
+}
+
+func (r *DataMaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan DataMaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider != "sqlserver" {
+		logging.AddError(ctx, "Invalid config",
+			"`azuresql_data_mask` resource is only supported on SQL databases.")
+		return
+	}
+
+	dataMask := sql.CreateDataMask(ctx, connection, toSqlDataMask(plan))
+
+	if logging.HasError(ctx) {
+		if dataMask.Id != "" {
+			logging.AddError(
+				ctx,
+				"Data mask already exists",
+				fmt.Sprintf("You can import this resource using `terraform import azuresql_data_mask.<name> %s", dataMask.Id))
+		}
+		return
+	}
+
+	setState(&plan, dataMask)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DataMaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state DataMaskResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	dataMask := sql.GetDataMaskFromId(ctx, connection, state.Id.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if dataMask.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setState(&state, dataMask)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DataMaskResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *DataMaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan DataMaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	dataMask := sql.ParseDataMaskId(ctx, plan.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	update := toSqlDataMask(plan)
+	update.ObjectId = dataMask.ObjectId
+	update.ColumnId = dataMask.ColumnId
+
+	// adding a mask to a masked column replaces the masking function
+	sql.UpdateDataMask(ctx, connection, update)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	dataMask = sql.GetDataMaskFromId(ctx, connection, plan.Id.ValueString(), true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	setState(&plan, dataMask)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DataMaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state DataMaskResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	sql.DropDataMask(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Dropping data mask failed", fmt.Sprintf("Dropping data mask of column %s failed", state.Column.ValueString()))
+	}
+}
+
+func (r *DataMaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing data mask %s", req.ID))
+
+	dataMask := sql.ParseDataMaskId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, dataMask.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	dataMask = sql.GetDataMaskFromId(ctx, connection, req.ID, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := DataMaskResourceModel{
+		Database: types.StringValue(dataMask.Connection),
+	}
+	setState(&state, dataMask)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,129 @@
+package data_mask_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type DataMaskResource struct{}
+
+func TestAccCreateDataMask(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := DataMaskResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+
+		acceptance.ExecuteSQL(connection, fmt.Sprintf("create table dbo.tftable_%s (email varchar(100), phone varchar(20))", data.RandomString))
+		defer acceptance.ExecuteSQL(connection, fmt.Sprintf("DROP table dbo.tftable_%s", data.RandomString))
+
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.email(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("azuresql_data_mask.test", "function", "email"),
+						resource.TestCheckResourceAttrPair("azuresql_permission.test", "scope", "azuresql_data_mask.test", "column_scope"),
+					),
+				},
+				{
+					Config:                   r.email(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_data_mask.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+				{
+					Config:                   r.email(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_permission.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+				{
+					Config:                   r.partial(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("azuresql_data_mask.test", "function", "partial"),
+						resource.TestCheckResourceAttr("azuresql_data_mask.test", "padding", "XXXX"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func (r DataMaskResource) email(connection string, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_data_mask" "test" {
+		database 	= "%[2]s"
+		table 		= data.azuresql_table.test.id
+		column 		= "email"
+		function 	= "email"
+	}
+
+	resource "azuresql_permission" "test" {
+		database 	= "%[2]s"
+		scope 		= azuresql_data_mask.test.column_scope
+		principal 	= azuresql_role.test.id
+		permission 	= "unmask"
+	}
+`, r.template(connection, name), connection, name)
+}
+
+func (r DataMaskResource) partial(connection string, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_data_mask" "test" {
+		database 	= "%[2]s"
+		table 		= data.azuresql_table.test.id
+		column 		= "email"
+		function 	= "partial"
+		prefix 		= 1
+		padding 	= "XXXX"
+		suffix 		= 0
+	}
+
+	resource "azuresql_permission" "test" {
+		database 	= "%[2]s"
+		scope 		= azuresql_data_mask.test.column_scope
+		principal 	= azuresql_role.test.id
+		permission 	= "unmask"
+	}
+`, r.template(connection, name), connection, name)
+}
+
+func (r DataMaskResource) template(connection string, name string) string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+
+		data "azuresql_schema" "dbo" {
+			database 	= "%[1]s"
+			name 		= "dbo"
+		}
+
+		data "azuresql_table" "test" {
+			database 	= "%[1]s"
+			schema 		= data.azuresql_schema.dbo.id
+			name 		= "tftable_%[2]s"
+		}
+
+		resource "azuresql_role" "test" {
+			database 	= "%[1]s"
+			name 		= "tfrole_%[2]s"
+		}
+	`, connection, name)
+}
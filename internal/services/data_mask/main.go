@@ -0,0 +1,20 @@
+package data_mask
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DataMaskResourceModel struct {
+	Id          types.String  `tfsdk:"id"`
+	Database    types.String  `tfsdk:"database"`
+	Table       types.String  `tfsdk:"table"`
+	Column      types.String  `tfsdk:"column"`
+	ColumnId    types.Int64   `tfsdk:"column_id"`
+	ColumnScope types.String  `tfsdk:"column_scope"`
+	Function    types.String  `tfsdk:"function"`
+	Prefix      types.Int64   `tfsdk:"prefix"`
+	Padding     types.String  `tfsdk:"padding"`
+	Suffix      types.Int64   `tfsdk:"suffix"`
+	Start       types.Float64 `tfsdk:"start"`
+	End         types.Float64 `tfsdk:"end"`
+}
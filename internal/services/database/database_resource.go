@@ -3,15 +3,21 @@ package database
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"terraform-provider-azuresql/internal/logging"
 	"terraform-provider-azuresql/internal/sql"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,6 +26,7 @@ var (
 	_ resource.Resource                = &DatabaseResource{}
 	_ resource.ResourceWithConfigure   = &DatabaseResource{}
 	_ resource.ResourceWithImportState = &DatabaseResource{}
+	_ resource.ResourceWithModifyPlan  = &DatabaseResource{}
 )
 
 func NewDatabaseResource() resource.Resource {
@@ -56,10 +63,180 @@ func (r *DatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"edition": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Edition of the database, e.g. `Basic`, `Standard`, `Premium`, `GeneralPurpose` or `Hyperscale`. Only supported in SQL Server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_objective": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Service objective of the database, e.g. `S0` or `GP_S_Gen5_2`. Only supported in SQL Server.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("elastic_pool")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_size": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum size of the database in the format `<n> MB` or `<n> GB`. Only supported in SQL Server.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d+ ?(MB|GB)$`), "must have the format `<n> MB` or `<n> GB`"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"elastic_pool": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the elastic pool the database is placed in. Only supported in SQL Server.",
+			},
+			"collation": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Collation of the database.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9_]+$`), "must be a collation name, e.g. `Latin1_General_100_CI_AS_SC_UTF8`"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"catalog_collation": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Collation of the metadata catalog. Possible values are `DATABASE_DEFAULT` and `SQL_Latin1_General_CP1_CI_AS`. Only supported in SQL Server.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("DATABASE_DEFAULT", "SQL_Latin1_General_CP1_CI_AS"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"copy_of": schema.StringAttribute{
+				Optional:    true,
+				Description: "Create the database as a copy of this database, in the format `<database>` or `<server>.<database>`. Only supported in SQL Server.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("collation"),
+						path.MatchRoot("catalog_collation"),
+						path.MatchRoot("max_size"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When `true`, destroying the resource fails and the database is kept. Defaults to `false`.",
+			},
+			"on_destroy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("kill_sessions"),
+				Description: "How sessions connected to the database are handled when it is dropped. Possible values are `fail_if_sessions`, `kill_sessions` and `set_single_user`. `set_single_user` isn't supported in Azure SQL Database. Defaults to `kill_sessions`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("fail_if_sessions", "kill_sessions", "set_single_user"),
+				},
+			},
+			"fail_if_user_tables": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When `true`, dropping the database fails if it contains user tables. Defaults to `false`.",
+			},
 		},
 	}
 }
 
+func (r *DatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	// no modification required on create or delete
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan, config DatabaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.MaxSize.IsUnknown() && sql.IsMaxSizeEquivalent(plan.MaxSize.ValueString(), state.MaxSize.ValueString()) {
+		plan.MaxSize = state.MaxSize
+	}
+
+	// scaling the database can change the edition, service objective and max size
+	// -> values that are not configured are only known after the update
+	scaled := !plan.Edition.Equal(state.Edition) ||
+		!plan.ServiceObjective.Equal(state.ServiceObjective) ||
+		!plan.ElasticPool.Equal(state.ElasticPool)
+
+	if scaled {
+		if config.Edition.IsNull() {
+			plan.Edition = types.StringUnknown()
+		}
+		if config.ServiceObjective.IsNull() {
+			plan.ServiceObjective = types.StringUnknown()
+		}
+		if config.MaxSize.IsNull() {
+			plan.MaxSize = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+func toSqlSettings(plan DatabaseResourceModel) sql.DatabaseSettings {
+	return sql.DatabaseSettings{
+		Edition:          plan.Edition.ValueString(),
+		ServiceObjective: plan.ServiceObjective.ValueString(),
+		MaxSize:          plan.MaxSize.ValueString(),
+		ElasticPool:      plan.ElasticPool.ValueString(),
+		Collation:        plan.Collation.ValueString(),
+		CatalogCollation: plan.CatalogCollation.ValueString(),
+		CopyOf:           plan.CopyOf.ValueString(),
+	}
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func setState(state *DatabaseResourceModel, database sql.Database) {
+	state.ConnectionId = types.StringValue(database.Id)
+	state.Name = types.StringValue(database.Name)
+	state.Edition = optionalString(database.Edition)
+	state.ElasticPool = optionalString(database.ElasticPool)
+	state.Collation = optionalString(database.Collation)
+	state.CatalogCollation = optionalString(database.CatalogCollation)
+
+	// databases in an elastic pool report the service objective `ElasticPool`
+	state.ServiceObjective = optionalString(database.ServiceObjective)
+
+	// keep the notation of the max size in the current state
+	if !sql.IsMaxSizeEquivalent(state.MaxSize.ValueString(), database.MaxSize) {
+		state.MaxSize = optionalString(database.MaxSize)
+	}
+}
+
 func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
 
@@ -78,10 +255,24 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	if connection.Provider == "sqlserver" {
-		logging.AddError(ctx, "Invalid config",
-			"`azuresql_database` does not support creating databases in sqlserver. Use the `azurerm_mssql_database` resource from the `azurerm` provider instead.")
-		return
+	if connection.Provider != "sqlserver" {
+		settings := map[string]types.String{
+			"edition":           plan.Edition,
+			"service_objective": plan.ServiceObjective,
+			"max_size":          plan.MaxSize,
+			"elastic_pool":      plan.ElasticPool,
+			"catalog_collation": plan.CatalogCollation,
+			"copy_of":           plan.CopyOf,
+		}
+		for name, value := range settings {
+			if !value.IsNull() && !value.IsUnknown() {
+				logging.AddAttributeError(ctx, path.Root(name), "Invalid config",
+					fmt.Sprintf("`%s` is only supported for databases in SQL Server.", name))
+			}
+		}
+		if logging.HasError(ctx) {
+			return
+		}
 	}
 
 	if connection.Provider == "synapsededicated" {
@@ -96,7 +287,7 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	database := sql.CreateDatabase(ctx, connection, name)
+	database := sql.CreateDatabase(ctx, connection, name, toSqlSettings(plan))
 
 	if logging.HasError(ctx) {
 		if database.Id != "" {
@@ -108,7 +299,7 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	plan.ConnectionId = types.StringValue(database.Id)
+	setState(&plan, database)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -127,22 +318,35 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		req.State.Get(ctx, &state)...,
 	)
 
-	connection := sql.ParseConnectionId(ctx, state.ConnectionId.ValueString())
+	connection := r.ConnectionCache.Connect(ctx, state.Server.ValueString(), true, false)
+
 	if logging.HasError(ctx) {
 		return
 	}
 
-	status := r.ConnectionCache.DatabaseExists(ctx, connection)
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	database := sql.GetDatabaseFromName(ctx, connection, state.Name.ValueString())
 
 	if logging.HasError(ctx) {
 		return
 	}
 
-	if status == sql.ConnectionResourceStatusNotFound {
+	if database.Id == "" {
 		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	return
+	setState(&state, database)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *DatabaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -167,7 +371,60 @@ func (r *DatabaseResource) Configure(_ context.Context, req resource.ConfigureRe
 
 func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
-	logging.AddError(ctx, "Update not implemented", "Update should never be called for database resources as any change requires a delete and recreate.")
+
+	var plan, state DatabaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, plan.Server.ValueString(), true, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	// only modify the settings that changed, unknown values are left to the server
+	var settings sql.DatabaseSettings
+	if !plan.Edition.IsUnknown() && !plan.Edition.Equal(state.Edition) {
+		settings.Edition = plan.Edition.ValueString()
+	}
+	if !plan.ServiceObjective.IsUnknown() && !plan.ServiceObjective.Equal(state.ServiceObjective) {
+		settings.ServiceObjective = plan.ServiceObjective.ValueString()
+	}
+	if !plan.MaxSize.IsUnknown() && !sql.IsMaxSizeEquivalent(plan.MaxSize.ValueString(), state.MaxSize.ValueString()) {
+		settings.MaxSize = plan.MaxSize.ValueString()
+	}
+	if !plan.ElasticPool.Equal(state.ElasticPool) {
+		settings.ElasticPool = plan.ElasticPool.ValueString()
+
+		if plan.ElasticPool.IsNull() && settings.ServiceObjective == "" {
+			logging.AddAttributeError(ctx, path.Root("service_objective"), "Invalid config",
+				"`service_objective` is required to move the database out of an elastic pool.")
+			return
+		}
+	}
+
+	sql.UpdateDatabase(ctx, connection, plan.Name.ValueString(), settings)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	database := sql.GetDatabaseFromName(ctx, connection, plan.Name.ValueString())
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	setState(&plan, database)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -180,6 +437,12 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		req.State.Get(ctx, &state)...,
 	)
 
+	if state.DeletionProtection.ValueBool() {
+		logging.AddError(ctx, "Database is protected",
+			fmt.Sprintf("Database %s has `deletion_protection` enabled. Set `deletion_protection` to `false` and apply before destroying the database.", state.Name.ValueString()))
+		return
+	}
+
 	connection := r.ConnectionCache.Connect(ctx, state.Server.ValueString(), true, false)
 
 	if logging.HasError(ctx) {
@@ -190,7 +453,36 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	sql.DropDatabase(ctx, connection, state.Name.ValueString())
+	if state.FailIfUserTables.ValueBool() {
+		databaseConnection := r.ConnectionCache.Connect(ctx, state.ConnectionId.ValueString(), false, false)
+
+		if logging.HasError(ctx) {
+			return
+		}
+
+		if databaseConnection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+			return
+		}
+
+		tables := sql.GetUserTableCount(ctx, databaseConnection)
+
+		if logging.HasError(ctx) {
+			return
+		}
+
+		if tables > 0 {
+			logging.AddError(ctx, "Database contains user tables",
+				fmt.Sprintf("Database %s contains %d user tables. Drop the tables or set `fail_if_user_tables` to `false` to drop the database.", state.Name.ValueString(), tables))
+			return
+		}
+	}
+
+	onDestroy := state.OnDestroy.ValueString()
+	if state.OnDestroy.IsNull() {
+		onDestroy = "kill_sessions"
+	}
+
+	sql.DropDatabase(ctx, connection, state.Name.ValueString(), onDestroy)
 
 	if logging.HasError(ctx) {
 		resp.Diagnostics.AddError("Dropping database failed", fmt.Sprintf("Dropping database %s failed", state.Name.ValueString()))
@@ -207,12 +499,6 @@ func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportS
 		return
 	}
 
-	if connection.Provider == "sqlserver" {
-		logging.AddError(ctx, "Invalid config",
-			"`azuresql_database` does not support creation & deletion of databases in sqlserver. Use the `azurerm_mssql_database` resource from the `azurerm` provider instead.")
-		return
-	}
-
 	status := r.ConnectionCache.DatabaseExists(ctx, connection)
 
 	if logging.HasError(ctx) {
@@ -228,6 +514,10 @@ func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportS
 		ConnectionId: types.StringValue(connection.ConnectionId),
 		Server:       types.StringValue(strings.TrimSuffix(connection.ConnectionId, ":"+connection.Database)),
 		Name:         types.StringValue(connection.Database),
+		// import with the default destroy settings
+		DeletionProtection: types.BoolValue(false),
+		OnDestroy:          types.StringValue("kill_sessions"),
+		FailIfUserTables:   types.BoolValue(false),
 	}
 
 	diags := resp.State.Set(ctx, &state)
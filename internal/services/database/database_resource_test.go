@@ -87,6 +87,39 @@ func TestAccCreateSchemaInNewDatabase(t *testing.T) {
 	}
 }
 
+func TestAccScaleSqlDatabase(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := DatabaseResource{}
+
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:                   r.service_objective(data.SQLServer_connection, data.RandomString, "S0"),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuresql_database.test", "edition", "Standard"),
+					resource.TestCheckResourceAttr("azuresql_database.test", "service_objective", "S0"),
+				),
+			},
+			{
+				Config:                   r.service_objective(data.SQLServer_connection, data.RandomString, "S1"),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuresql_database.test", "service_objective", "S1"),
+				),
+			},
+			{
+				Config:                   r.service_objective(data.SQLServer_connection, data.RandomString, "S1"),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				ResourceName:             "azuresql_database.test",
+				ImportState:              true,
+				ImportStateVerify:        true,
+			},
+		},
+	})
+}
+
 func (r DatabaseResource) basic(server string, name string) string {
 	template := r.template()
 
@@ -121,6 +154,23 @@ func (r DatabaseResource) create_schema_in_new_database(server string, name stri
 		`, template, server, name)
 }
 
+func (r DatabaseResource) service_objective(server string, name string, serviceObjective string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_database" "test" {
+			server	 			= "%[2]s"
+			name     			= "tfdatabase_%[3]s"
+			edition				= "Standard"
+			service_objective	= "%[4]s"
+			max_size			= "250 GB"
+		}
+		`, template, server, name, serviceObjective)
+}
+
 func (r DatabaseResource) template() string {
 	return fmt.Sprintf(`
 		provider "azuresql" {
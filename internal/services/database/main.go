@@ -9,7 +9,17 @@ type databaseDataSourceModel struct {
 }
 
 type DatabaseResourceModel struct {
-	ConnectionId types.String `tfsdk:"id"`
-	Server       types.String `tfsdk:"server"`
-	Name         types.String `tfsdk:"name"`
+	ConnectionId       types.String `tfsdk:"id"`
+	Server             types.String `tfsdk:"server"`
+	Name               types.String `tfsdk:"name"`
+	Edition            types.String `tfsdk:"edition"`
+	ServiceObjective   types.String `tfsdk:"service_objective"`
+	MaxSize            types.String `tfsdk:"max_size"`
+	ElasticPool        types.String `tfsdk:"elastic_pool"`
+	Collation          types.String `tfsdk:"collation"`
+	CatalogCollation   types.String `tfsdk:"catalog_collation"`
+	CopyOf             types.String `tfsdk:"copy_of"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	OnDestroy          types.String `tfsdk:"on_destroy"`
+	FailIfUserTables   types.Bool   `tfsdk:"fail_if_user_tables"`
 }
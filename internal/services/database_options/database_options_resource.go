@@ -0,0 +1,368 @@
+package database_options
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DatabaseOptionsResource{}
+	_ resource.ResourceWithConfigure   = &DatabaseOptionsResource{}
+	_ resource.ResourceWithImportState = &DatabaseOptionsResource{}
+)
+
+func NewDatabaseOptionsResource() resource.Resource {
+	return &DatabaseOptionsResource{}
+}
+
+type DatabaseOptionsResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *DatabaseOptionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_options"
+}
+
+func (r *DatabaseOptionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Database scoped configurations and database options. Only configured options are managed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"maxdop": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Max degree of parallelism (`MAXDOP` database scoped configuration). 0 lets SQL decide.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"legacy_cardinality_estimation": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "`LEGACY_CARDINALITY_ESTIMATION` database scoped configuration.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"query_optimizer_hotfixes": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "`QUERY_OPTIMIZER_HOTFIXES` database scoped configuration.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compatibility_level": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Compatibility level of the database, e.g. `160`.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.OneOf(100, 110, 120, 130, 140, 150, 160, 170),
+				},
+			},
+			"read_committed_snapshot": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "`READ_COMMITTED_SNAPSHOT` database option.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"query_store_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Desired state of the Query Store. Possible values are `OFF`, `READ_ONLY` and `READ_WRITE`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("OFF", "READ_ONLY", "READ_WRITE"),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the login owning the database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func setState(state *DatabaseOptionsResourceModel, options sql.DatabaseOptions) {
+	state.Id = types.StringValue(options.Id)
+	state.MaxDop = types.Int64Value(options.MaxDop)
+	state.LegacyCardinalityEstimation = types.BoolValue(options.LegacyCardinalityEstimation)
+	state.QueryOptimizerHotfixes = types.BoolValue(options.QueryOptimizerHotfixes)
+	state.CompatibilityLevel = types.Int64Value(options.CompatibilityLevel)
+	state.ReadCommittedSnapshot = types.BoolValue(options.ReadCommittedSnapshot)
+	state.QueryStoreMode = types.StringValue(options.QueryStoreMode)
+
+	// the owner isn't always resolvable from within the database, e.g. for Entra ID logins
+	if options.Owner != "" && !strings.EqualFold(options.Owner, state.Owner.ValueString()) {
+		state.Owner = types.StringValue(options.Owner)
+	}
+	if state.Owner.IsUnknown() {
+		state.Owner = types.StringValue(options.Owner)
+	}
+}
+
+func isSet(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// Apply the configured options that differ from the current options
+func applyOptions(ctx context.Context, connection sql.Connection, plan DatabaseOptionsResourceModel, current sql.DatabaseOptions) {
+	if isSet(plan.MaxDop) && plan.MaxDop.ValueInt64() != current.MaxDop {
+		sql.SetMaxDop(ctx, connection, plan.MaxDop.ValueInt64())
+	}
+	if isSet(plan.LegacyCardinalityEstimation) && plan.LegacyCardinalityEstimation.ValueBool() != current.LegacyCardinalityEstimation {
+		sql.SetLegacyCardinalityEstimation(ctx, connection, plan.LegacyCardinalityEstimation.ValueBool())
+	}
+	if isSet(plan.QueryOptimizerHotfixes) && plan.QueryOptimizerHotfixes.ValueBool() != current.QueryOptimizerHotfixes {
+		sql.SetQueryOptimizerHotfixes(ctx, connection, plan.QueryOptimizerHotfixes.ValueBool())
+	}
+	if isSet(plan.CompatibilityLevel) && plan.CompatibilityLevel.ValueInt64() != current.CompatibilityLevel {
+		sql.SetCompatibilityLevel(ctx, connection, plan.CompatibilityLevel.ValueInt64())
+	}
+	if isSet(plan.ReadCommittedSnapshot) && plan.ReadCommittedSnapshot.ValueBool() != current.ReadCommittedSnapshot {
+		sql.SetReadCommittedSnapshot(ctx, connection, plan.ReadCommittedSnapshot.ValueBool())
+	}
+	if isSet(plan.QueryStoreMode) && plan.QueryStoreMode.ValueString() != current.QueryStoreMode {
+		sql.SetQueryStoreMode(ctx, connection, plan.QueryStoreMode.ValueString())
+	}
+	if isSet(plan.Owner) && !strings.EqualFold(plan.Owner.ValueString(), current.Owner) {
+		sql.SetDatabaseOwner(ctx, connection, plan.Owner.ValueString())
+	}
+}
+
+func (r *DatabaseOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan DatabaseOptionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider != "sqlserver" {
+		logging.AddError(ctx, "Invalid config",
+			"`azuresql_database_options` resource is only supported on SQL databases.")
+		return
+	}
+
+	current := sql.GetDatabaseOptions(ctx, connection)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	applyOptions(ctx, connection, plan, current)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	options := sql.GetDatabaseOptions(ctx, connection)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	setState(&plan, options)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DatabaseOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state DatabaseOptionsResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	options := sql.GetDatabaseOptions(ctx, connection)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	setState(&state, options)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DatabaseOptionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *DatabaseOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan DatabaseOptionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	current := sql.GetDatabaseOptions(ctx, connection)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	applyOptions(ctx, connection, plan, current)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	options := sql.GetDatabaseOptions(ctx, connection)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	setState(&plan, options)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// The options are kept when the resource is destroyed, as there is no sensible value to reset them to.
+func (r *DatabaseOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+}
+
+func (r *DatabaseOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing database options %s", req.ID))
+
+	options := sql.ParseDatabaseOptionsId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, options.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	options = sql.GetDatabaseOptions(ctx, connection)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := DatabaseOptionsResourceModel{
+		Database: types.StringValue(options.Connection),
+		Owner:    types.StringNull(),
+	}
+	setState(&state, options)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,81 @@
+package database_options_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type DatabaseOptionsResource struct{}
+
+func TestAccCreateDatabaseOptions(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := DatabaseOptionsResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+
+		// restore the defaults after the test, as destroying the resource keeps the options
+		defer acceptance.ExecuteSQL(connection, "alter database scoped configuration set maxdop = 8; alter database scoped configuration set legacy_cardinality_estimation = off")
+
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.basic(connection, 4, "true"),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("azuresql_database_options.test", "maxdop", "4"),
+						resource.TestCheckResourceAttr("azuresql_database_options.test", "legacy_cardinality_estimation", "true"),
+						resource.TestCheckResourceAttrSet("azuresql_database_options.test", "compatibility_level"),
+					),
+				},
+				{
+					Config:                   r.basic(connection, 4, "true"),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_database_options.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+					ImportStateVerifyIgnore:  []string{"owner"},
+				},
+				{
+					PreConfig: func() {
+						// drift is reported and corrected
+						acceptance.ExecuteSQL(connection, "alter database scoped configuration set maxdop = 2")
+					},
+					Config:                   r.basic(connection, 4, "false"),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("azuresql_database_options.test", "maxdop", "4"),
+						resource.TestCheckResourceAttr("azuresql_database_options.test", "legacy_cardinality_estimation", "false"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func (r DatabaseOptionsResource) basic(connection string, maxdop int, legacyCardinalityEstimation string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_database_options" "test" {
+		database 						= "%[2]s"
+		maxdop 							= %[3]d
+		legacy_cardinality_estimation 	= %[4]s
+	}
+`, r.template(), connection, maxdop, legacyCardinalityEstimation)
+}
+
+func (r DatabaseOptionsResource) template() string {
+	return `
+		provider "azuresql" {
+		}
+	`
+}
@@ -0,0 +1,17 @@
+package database_options
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DatabaseOptionsResourceModel struct {
+	Id                          types.String `tfsdk:"id"`
+	Database                    types.String `tfsdk:"database"`
+	MaxDop                      types.Int64  `tfsdk:"maxdop"`
+	LegacyCardinalityEstimation types.Bool   `tfsdk:"legacy_cardinality_estimation"`
+	QueryOptimizerHotfixes      types.Bool   `tfsdk:"query_optimizer_hotfixes"`
+	CompatibilityLevel          types.Int64  `tfsdk:"compatibility_level"`
+	ReadCommittedSnapshot       types.Bool   `tfsdk:"read_committed_snapshot"`
+	QueryStoreMode              types.String `tfsdk:"query_store_mode"`
+	Owner                       types.String `tfsdk:"owner"`
+}
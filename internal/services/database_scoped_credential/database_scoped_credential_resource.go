@@ -3,21 +3,27 @@ package database_scoped_credential
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"terraform-provider-azuresql/internal/logging"
 	"terraform-provider-azuresql/internal/sql"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource              = &DatabaseScopedCredentialResource{}
-	_ resource.ResourceWithConfigure = &DatabaseScopedCredentialResource{}
+	_ resource.Resource                   = &DatabaseScopedCredentialResource{}
+	_ resource.ResourceWithConfigure      = &DatabaseScopedCredentialResource{}
+	_ resource.ResourceWithValidateConfig = &DatabaseScopedCredentialResource{}
 )
 
 func NewDatabaseScopedCredentialResource() resource.Resource {
@@ -57,9 +63,6 @@ func (r *DatabaseScopedCredentialResource) Schema(_ context.Context, _ resource.
 			"identity": schema.StringAttribute{
 				Required:    true,
 				Description: "Identify of the datbase scoped credential.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"secret": schema.StringAttribute{
 				Optional:    true,
@@ -67,6 +70,22 @@ func (r *DatabaseScopedCredentialResource) Schema(_ context.Context, _ resource.
 				Default:     stringdefault.StaticString(""),
 				Description: "Secret for the database scoped credential. Leave blank to use no secret.",
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("secret_wo")),
+				},
+			},
+			"secret_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "Write-only secret for the database scoped credential, which is never stored in the state. Change secret_version to update the secret.",
+				Sensitive:   true,
+			},
+			"secret_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of secret_wo. The secret is only updated when the version changes.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("secret_wo")),
+				},
 			},
 			"credential_id": schema.Int64Attribute{
 				Computed:    true,
@@ -76,12 +95,40 @@ func (r *DatabaseScopedCredentialResource) Schema(_ context.Context, _ resource.
 	}
 }
 
+func (r DatabaseScopedCredentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var data DatabaseScopedCredentialResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Identity.IsUnknown() || data.Secret.IsUnknown() || data.SecretWo.IsUnknown() {
+		return
+	}
+
+	if message := sql.ValidateDatabaseScopedCredential(data.Identity.ValueString(), getSecret(data)); message != "" {
+		logging.AddAttributeError(ctx, path.Root("identity"), "Invalid attribute configuration", message)
+	}
+}
+
+// The secret is taken from secret_wo when specified. Write-only values are only
+// available in the config.
+func getSecret(config DatabaseScopedCredentialResourceModel) string {
+	if !config.SecretWo.IsNull() {
+		return config.SecretWo.ValueString()
+	}
+	return config.Secret.ValueString()
+}
+
 func (r *DatabaseScopedCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
 
-	var plan DatabaseScopedCredentialResourceModel
+	var plan, config DatabaseScopedCredentialResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -103,7 +150,7 @@ func (r *DatabaseScopedCredentialResource) Create(ctx context.Context, req resou
 		connection,
 		plan.Name.ValueString(),
 		plan.Identity.ValueString(),
-		plan.Secret.ValueString(),
+		getSecret(config),
 	)
 
 	if logging.HasError(ctx) {
@@ -160,7 +207,10 @@ func (r *DatabaseScopedCredentialResource) Read(ctx context.Context, req resourc
 	}
 
 	state.Name = types.StringValue(databaseScopedCredential.Name)
-	state.Identity = types.StringValue(databaseScopedCredential.Identity)
+	// special identities are written with their canonical spelling, keep the configured case
+	if !strings.EqualFold(state.Identity.ValueString(), databaseScopedCredential.Identity) {
+		state.Identity = types.StringValue(databaseScopedCredential.Identity)
+	}
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -192,9 +242,10 @@ func (r *DatabaseScopedCredentialResource) Configure(_ context.Context, req reso
 func (r *DatabaseScopedCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
 
-	var plan DatabaseScopedCredentialResourceModel
+	var plan, config DatabaseScopedCredentialResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -206,12 +257,13 @@ func (r *DatabaseScopedCredentialResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	// the identity and secret are always altered together, as altering the identity resets the secret
 	databaseScopedCredential := sql.AlterDatabaseScopedCredential(
 		ctx,
 		connection,
 		plan.Name.ValueString(),
 		plan.Identity.ValueString(),
-		plan.Secret.ValueString(),
+		getSecret(config),
 	)
 
 	if logging.HasError(ctx) {
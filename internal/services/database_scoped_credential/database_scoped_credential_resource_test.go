@@ -96,6 +96,40 @@ func TestAccUpdateCredential(t *testing.T) {
 	}
 }
 
+func TestAccUpdateCredentialWriteOnly(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := DatabaseScopedCredentialResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+		data.SynapseDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.write_only(connection, data.RandomString, "SHARED ACCESS SIGNATURE", "secret"+data.RandomString, 1),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check:                    resource.TestCheckNoResourceAttr("azuresql_database_scoped_credential.test", "secret_wo"),
+				},
+				{
+					Config:                   r.write_only(connection, data.RandomString, "SHARED ACCESS SIGNATURE", "secret2"+data.RandomString, 2),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check:                    resource.TestCheckResourceAttr("azuresql_database_scoped_credential.test", "secret_version", "2"),
+				},
+				{
+					Config:                   r.write_only(connection, data.RandomString, "user"+data.RandomString, "secret2"+data.RandomString, 2),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check:                    resource.TestCheckResourceAttr("azuresql_database_scoped_credential.test", "identity", "user"+data.RandomString),
+				},
+			},
+		})
+	}
+}
+
 func (r DatabaseScopedCredentialResource) basic(connection string, name string, identity string, secret string) string {
 	return fmt.Sprintf(`
 	%[1]s
@@ -125,6 +159,22 @@ func (r DatabaseScopedCredentialResource) without_secret(connection string, name
 `, r.template(connection), connection, name, identity)
 }
 
+func (r DatabaseScopedCredentialResource) write_only(connection string, name string, identity string, secret string, version int) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_database_scoped_credential" "test" {
+		database 		= "%[2]s"
+		name  			= "tfcredential_%[3]s"
+		identity  		= "%[4]s"
+		secret_wo  		= "%[5]s"
+		secret_version 	= %[6]d
+
+		depends_on = [azuresql_master_key.test]
+	}
+`, r.template(connection), connection, name, identity, secret, version)
+}
+
 func (r DatabaseScopedCredentialResource) template(connection string) string {
 	return fmt.Sprintf(`
 		provider "azuresql" {
@@ -5,10 +5,12 @@ import (
 )
 
 type DatabaseScopedCredentialResourceModel struct {
-	Id           types.String `tfsdk:"id"`
-	Database     types.String `tfsdk:"database"`
-	Name         types.String `tfsdk:"name"`
-	Identity     types.String `tfsdk:"identity"`
-	Secret       types.String `tfsdk:"secret"`
-	CredentialId types.Int64  `tfsdk:"credential_id"`
+	Id            types.String `tfsdk:"id"`
+	Database      types.String `tfsdk:"database"`
+	Name          types.String `tfsdk:"name"`
+	Identity      types.String `tfsdk:"identity"`
+	Secret        types.String `tfsdk:"secret"`
+	SecretWo      types.String `tfsdk:"secret_wo"`
+	SecretVersion types.Int64  `tfsdk:"secret_version"`
+	CredentialId  types.Int64  `tfsdk:"credential_id"`
 }
@@ -2,15 +2,19 @@ package external_data_source
 
 import (
 	"context"
+	dbsql "database/sql"
 	"fmt"
 
 	"terraform-provider-azuresql/internal/logging"
 	"terraform-provider-azuresql/internal/sql"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -62,13 +66,59 @@ func (r *ExternalDataSourceResource) Schema(_ context.Context, _ resource.Schema
 			"location": schema.StringAttribute{
 				Required:    true,
 				Description: "Location of the external data source.",
+			},
+			"credential": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the azuresql_database_scoped_credential used to access the external data source.",
+				PlanModifiers: []planmodifier.String{
+					// a credential can be changed, but not removed
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.PlanValue.IsNull() && !req.StateValue.IsNull()
+						},
+						"Removing the credential recreates the external data source.",
+						"Removing the credential recreates the external data source.",
+					),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Type of the external data source: `BLOB_STORAGE`, `RDBMS`, `SHARD_MAP_MANAGER` or `HADOOP`.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"BLOB_STORAGE", "RDBMS", "SHARD_MAP_MANAGER", "HADOOP"}...),
+				},
 			},
-			"credential": schema.StringAttribute{
+			"database_name": schema.StringAttribute{
 				Optional:    true,
-				Description: "ID of the azuresql_database_scoped_credential used to access the external data source.",
+				Description: "Name of the remote database for `RDBMS` and `SHARD_MAP_MANAGER` data sources.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"shard_map_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the shard map for `SHARD_MAP_MANAGER` data sources.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pushdown": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "If true, computations can be pushed down to the external data source.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection_options": schema.StringAttribute{
+				Optional:    true,
+				Description: "Additional options used when connecting to the external data source.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -77,6 +127,57 @@ func (r *ExternalDataSourceResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+func nullString(value types.String) dbsql.NullString {
+	return dbsql.NullString{String: value.ValueString(), Valid: !value.IsNull() && !value.IsUnknown()}
+}
+
+func stringValue(value dbsql.NullString) types.String {
+	if !value.Valid {
+		return types.StringNull()
+	}
+	return types.StringValue(value.String)
+}
+
+func getExternalDataSource(model ExternalDataSourceResourceModel) sql.ExternalDataSource {
+	externalDataSource := sql.ExternalDataSource{
+		Location:          model.Location.ValueString(),
+		Credential:        model.Credential.ValueString(),
+		DatabaseName:      nullString(model.DatabaseName),
+		ShardMapName:      nullString(model.ShardMapName),
+		Pushdown:          dbsql.NullBool{Bool: model.Pushdown.ValueBool(), Valid: !model.Pushdown.IsNull() && !model.Pushdown.IsUnknown()},
+		ConnectionOptions: nullString(model.ConnectionOptions),
+	}
+
+	if !model.Type.IsUnknown() {
+		externalDataSource.Type = model.Type.ValueString()
+	}
+
+	return externalDataSource
+}
+
+func setState(model *ExternalDataSourceResourceModel, externalDataSource sql.ExternalDataSource) {
+	model.Id = types.StringValue(externalDataSource.Id)
+	model.DataSourceId = types.Int64Value(externalDataSource.DataSourceId)
+	model.Name = types.StringValue(externalDataSource.Name)
+	model.Location = types.StringValue(externalDataSource.Location)
+	model.Type = types.StringValue(externalDataSource.Type)
+	model.DatabaseName = stringValue(externalDataSource.DatabaseName)
+	model.ShardMapName = stringValue(externalDataSource.ShardMapName)
+	model.ConnectionOptions = stringValue(externalDataSource.ConnectionOptions)
+
+	if externalDataSource.Credential != "" {
+		model.Credential = types.StringValue(externalDataSource.Credential)
+	} else {
+		model.Credential = types.StringNull()
+	}
+
+	if externalDataSource.Pushdown.Valid {
+		model.Pushdown = types.BoolValue(externalDataSource.Pushdown.Bool)
+	} else {
+		model.Pushdown = types.BoolNull()
+	}
+}
+
 func (r *ExternalDataSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
 
@@ -100,7 +201,7 @@ func (r *ExternalDataSourceResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	externalDataSource := sql.CreateExternalDataSource(ctx, connection, name, plan.Location.ValueString(), plan.Credential.ValueString())
+	externalDataSource := sql.CreateExternalDataSource(ctx, connection, name, getExternalDataSource(plan))
 
 	if logging.HasError(ctx) {
 		if externalDataSource.Id != "" {
@@ -112,8 +213,7 @@ func (r *ExternalDataSourceResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	plan.Id = types.StringValue(externalDataSource.Id)
-	plan.DataSourceId = types.Int64Value(externalDataSource.DataSourceId)
+	setState(&plan, externalDataSource)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -155,12 +255,7 @@ func (r *ExternalDataSourceResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	state.DataSourceId = types.Int64Value(externalDataSource.DataSourceId)
-	state.Name = types.StringValue(externalDataSource.Name)
-	state.Location = types.StringValue(externalDataSource.Location)
-	if externalDataSource.Credential != "" {
-		state.Credential = types.StringValue(externalDataSource.Credential)
-	}
+	setState(&state, externalDataSource)
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -191,7 +286,32 @@ func (r *ExternalDataSourceResource) Configure(_ context.Context, req resource.C
 
 func (r *ExternalDataSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
-	logging.AddError(ctx, "Update not implemented", "Update should never be called for external data source resources.")
+
+	var state, plan ExternalDataSourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, state.Database.ValueString(), false, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	sql.UpdateExternalDataSource(ctx, connection, state.Id.ValueString(), plan.Location.ValueString(), plan.Credential.ValueString())
+	if logging.HasError(ctx) {
+		return
+	}
+
+	externalDataSource := sql.GetExternalDataSourceFromId(ctx, connection, state.Id.ValueString(), true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	setState(&plan, externalDataSource)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ExternalDataSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -251,16 +371,9 @@ func (r *ExternalDataSourceResource) ImportState(ctx context.Context, req resour
 	}
 
 	state := ExternalDataSourceResourceModel{
-		Id:           types.StringValue(externalDataSource.Id),
-		Name:         types.StringValue(externalDataSource.Name),
-		Database:     types.StringValue(externalDataSource.Connection),
-		DataSourceId: types.Int64Value(externalDataSource.DataSourceId),
-		Location:     types.StringValue(externalDataSource.Location),
-	}
-
-	if externalDataSource.Credential != "" {
-		state.Credential = types.StringValue(externalDataSource.Credential)
+		Database: types.StringValue(externalDataSource.Connection),
 	}
+	setState(&state, externalDataSource)
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
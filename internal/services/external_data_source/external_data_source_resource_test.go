@@ -72,6 +72,62 @@ func TestAccCreateExternalDataSourceWithCredential(t *testing.T) {
 	}
 }
 
+func TestAccUpdateExternalDataSourceLocation(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := ExternalDataSourceResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.withLocation(connection, data.RandomString, "abfs://test"),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.withLocation(connection, data.RandomString, "abfs://updated"),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check:                    resource.TestCheckResourceAttr("azuresql_external_data_source.test", "location", "abfs://updated"),
+				},
+			},
+		})
+	}
+}
+
+func TestAccCreateExternalDataSourceRDBMS(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := ExternalDataSourceResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.rdbms(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.rdbms(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_external_data_source.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
 func (r ExternalDataSourceResource) basic(database string, name string) string {
 	template := r.template()
 
@@ -115,6 +171,52 @@ func (r ExternalDataSourceResource) withCredential(database string, name string)
 		`, template, database, name)
 }
 
+func (r ExternalDataSourceResource) withLocation(database string, name string, location string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_external_data_source" "test" {
+			database	= "%[2]s"
+			name		= "tfdatasource_%[3]s"
+			location	= "%[4]s"
+		}
+		`, template, database, name, location)
+}
+
+func (r ExternalDataSourceResource) rdbms(database string, name string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_master_key" "test" {
+			database 	= "%[2]s"
+		}
+
+		resource "azuresql_database_scoped_credential" "test" {
+			database 	= "%[2]s"
+			name  		= "tfcredential_%[3]s"
+			identity  	= "remote_user"
+			secret		= "remote_password"
+
+			depends_on = [azuresql_master_key.test]
+		}
+
+		resource "azuresql_external_data_source" "test" {
+			database		= "%[2]s"
+			name			= "tfdatasource_%[3]s"
+			type			= "RDBMS"
+			location		= "myserver.database.windows.net"
+			database_name	= "remote_%[3]s"
+			credential  	= azuresql_database_scoped_credential.test.id
+		}
+		`, template, database, name)
+}
+
 func (r ExternalDataSourceResource) template() string {
 	return fmt.Sprintf(`
 		provider "azuresql" {
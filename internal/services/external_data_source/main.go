@@ -14,10 +14,15 @@ type ExternalDataSourceDataSourceModel struct {
 }
 
 type ExternalDataSourceResourceModel struct {
-	Id           types.String `tfsdk:"id"`
-	Database     types.String `tfsdk:"database"`
-	Name         types.String `tfsdk:"name"`
-	Location     types.String `tfsdk:"location"`
-	Credential   types.String `tfsdk:"credential"`
-	DataSourceId types.Int64  `tfsdk:"data_source_id"`
+	Id                types.String `tfsdk:"id"`
+	Database          types.String `tfsdk:"database"`
+	Name              types.String `tfsdk:"name"`
+	Location          types.String `tfsdk:"location"`
+	Credential        types.String `tfsdk:"credential"`
+	DataSourceId      types.Int64  `tfsdk:"data_source_id"`
+	Type              types.String `tfsdk:"type"`
+	DatabaseName      types.String `tfsdk:"database_name"`
+	ShardMapName      types.String `tfsdk:"shard_map_name"`
+	Pushdown          types.Bool   `tfsdk:"pushdown"`
+	ConnectionOptions types.String `tfsdk:"connection_options"`
 }
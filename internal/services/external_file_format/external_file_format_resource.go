@@ -0,0 +1,339 @@
+package external_file_format
+
+import (
+	"context"
+	dbsql "database/sql"
+	"fmt"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ExternalFileFormatResource{}
+	_ resource.ResourceWithConfigure   = &ExternalFileFormatResource{}
+	_ resource.ResourceWithImportState = &ExternalFileFormatResource{}
+)
+
+func NewExternalFileFormatResource() resource.Resource {
+	return &ExternalFileFormatResource{}
+}
+
+type ExternalFileFormatResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *ExternalFileFormatResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_file_format"
+}
+
+// Options which aren't configured take the value chosen by the database.
+func optionalString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func (r *ExternalFileFormatResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	encoding := optionalString("Encoding of delimited text files: `UTF8` or `UTF16`.")
+	encoding.Validators = []validator.String{
+		stringvalidator.OneOf([]string{"UTF8", "UTF16"}...),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Register an external file format.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database where the external file format should be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the external file format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_format_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the external file format in the database.",
+			},
+			"format_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Format of the external data: `PARQUET`, `DELIMITEDTEXT` or `DELTA`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"PARQUET", "DELIMITEDTEXT", "DELTA"}...),
+				},
+			},
+			"data_compression": optionalString("Compression method of the external data, e.g. `org.apache.hadoop.io.compress.SnappyCodec`."),
+			"field_terminator": optionalString("Character(s) separating the fields of delimited text files."),
+			"string_delimiter": optionalString("Character enclosing string values in delimited text files."),
+			"first_row": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "First row read from delimited text files, e.g. `2` to skip a header row.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"use_type_default": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "If true, missing values in delimited text files are replaced by the default value of the column type.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"encoding": encoding,
+		},
+	}
+}
+
+func nullString(value types.String) dbsql.NullString {
+	return dbsql.NullString{String: value.ValueString(), Valid: !value.IsNull() && !value.IsUnknown()}
+}
+
+func stringValue(value dbsql.NullString) types.String {
+	if !value.Valid {
+		return types.StringNull()
+	}
+	return types.StringValue(value.String)
+}
+
+func getFileFormat(model ExternalFileFormatResourceModel) sql.ExternalFileFormat {
+	return sql.ExternalFileFormat{
+		FormatType:      model.FormatType.ValueString(),
+		DataCompression: nullString(model.DataCompression),
+		FieldTerminator: nullString(model.FieldTerminator),
+		StringDelimiter: nullString(model.StringDelimiter),
+		FirstRow:        dbsql.NullInt64{Int64: model.FirstRow.ValueInt64(), Valid: !model.FirstRow.IsNull() && !model.FirstRow.IsUnknown()},
+		UseTypeDefault:  dbsql.NullBool{Bool: model.UseTypeDefault.ValueBool(), Valid: !model.UseTypeDefault.IsNull() && !model.UseTypeDefault.IsUnknown()},
+		Encoding:        nullString(model.Encoding),
+	}
+}
+
+func setState(model *ExternalFileFormatResourceModel, fileFormat sql.ExternalFileFormat) {
+	model.Id = types.StringValue(fileFormat.Id)
+	model.FileFormatId = types.Int64Value(fileFormat.FileFormatId)
+	model.Name = types.StringValue(fileFormat.Name)
+	model.FormatType = types.StringValue(fileFormat.FormatType)
+	model.DataCompression = stringValue(fileFormat.DataCompression)
+	model.FieldTerminator = stringValue(fileFormat.FieldTerminator)
+	model.StringDelimiter = stringValue(fileFormat.StringDelimiter)
+	model.Encoding = stringValue(fileFormat.Encoding)
+
+	if fileFormat.FirstRow.Valid {
+		model.FirstRow = types.Int64Value(fileFormat.FirstRow.Int64)
+	} else {
+		model.FirstRow = types.Int64Null()
+	}
+
+	if fileFormat.UseTypeDefault.Valid {
+		model.UseTypeDefault = types.BoolValue(fileFormat.UseTypeDefault.Bool)
+	} else {
+		model.UseTypeDefault = types.BoolNull()
+	}
+}
+
+func (r *ExternalFileFormatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan ExternalFileFormatResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider == "fabric" {
+		logging.AddError(ctx, "Invalid config", "External file formats are not supported in Fabric")
+		return
+	}
+
+	fileFormat := sql.CreateExternalFileFormat(ctx, connection, name, getFileFormat(plan))
+
+	if logging.HasError(ctx) {
+		if fileFormat.Id != "" {
+			logging.AddError(
+				ctx,
+				"External file format already exists",
+				fmt.Sprintf("You can import this resource using `terraform import azuresql_external_file_format.<name> %s", fileFormat.Id))
+		}
+		return
+	}
+
+	setState(&plan, fileFormat)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ExternalFileFormatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state ExternalFileFormatResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	fileFormat := sql.GetExternalFileFormatFromId(ctx, connection, state.Id.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if fileFormat.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setState(&state, fileFormat)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ExternalFileFormatResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *ExternalFileFormatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+}
+
+func (r *ExternalFileFormatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state ExternalFileFormatResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	sql.DropExternalFileFormat(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Dropping external file format failed", fmt.Sprintf("Dropping external file format %s failed", state.Name.ValueString()))
+	}
+}
+
+func (r *ExternalFileFormatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing external file format %s", req.ID))
+
+	fileFormat := sql.ParseExternalFileFormatId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, fileFormat.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	fileFormat = sql.GetExternalFileFormatFromId(ctx, connection, req.ID, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := ExternalFileFormatResourceModel{
+		Database: types.StringValue(fileFormat.Connection),
+	}
+	setState(&state, fileFormat)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,113 @@
+package external_file_format_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type ExternalFileFormatResource struct{}
+
+func TestAccCreateExternalFileFormatParquet(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := ExternalFileFormatResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+		data.SynapseDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.parquet(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.parquet(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_external_file_format.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func TestAccCreateExternalFileFormatDelimitedText(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := ExternalFileFormatResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+		data.SynapseDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.delimitedText(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.delimitedText(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_external_file_format.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func (r ExternalFileFormatResource) parquet(database string, name string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_external_file_format" "test" {
+			database			= "%[2]s"
+			name				= "tffileformat_%[3]s"
+			format_type			= "PARQUET"
+			data_compression	= "org.apache.hadoop.io.compress.SnappyCodec"
+		}
+		`, template, database, name)
+}
+
+func (r ExternalFileFormatResource) delimitedText(database string, name string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_external_file_format" "test" {
+			database			= "%[2]s"
+			name				= "tffileformat_%[3]s"
+			format_type			= "DELIMITEDTEXT"
+			field_terminator	= ","
+			string_delimiter	= "\""
+			first_row			= 2
+			encoding			= "UTF8"
+		}
+		`, template, database, name)
+}
+
+func (r ExternalFileFormatResource) template() string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+	`)
+}
@@ -0,0 +1,19 @@
+package external_file_format
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ExternalFileFormatResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Database        types.String `tfsdk:"database"`
+	Name            types.String `tfsdk:"name"`
+	FileFormatId    types.Int64  `tfsdk:"file_format_id"`
+	FormatType      types.String `tfsdk:"format_type"`
+	DataCompression types.String `tfsdk:"data_compression"`
+	FieldTerminator types.String `tfsdk:"field_terminator"`
+	StringDelimiter types.String `tfsdk:"string_delimiter"`
+	FirstRow        types.Int64  `tfsdk:"first_row"`
+	UseTypeDefault  types.Bool   `tfsdk:"use_type_default"`
+	Encoding        types.String `tfsdk:"encoding"`
+}
@@ -0,0 +1,402 @@
+package external_table
+
+import (
+	"context"
+	dbsql "database/sql"
+	"fmt"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ExternalTableResource{}
+	_ resource.ResourceWithConfigure   = &ExternalTableResource{}
+	_ resource.ResourceWithImportState = &ExternalTableResource{}
+)
+
+func NewExternalTableResource() resource.Resource {
+	return &ExternalTableResource{}
+}
+
+type ExternalTableResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *ExternalTableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_table"
+}
+
+func (r *ExternalTableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "External table reading data from an external data source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database where the external table should be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the external table",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the external table object in the database",
+			},
+			"schema": schema.StringAttribute{
+				Required:    true,
+				Description: "Schema where the external table resides.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"columns": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Columns of the external table.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"type": schema.StringAttribute{
+							Required: true,
+						},
+						"nullable": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+					},
+				},
+			},
+			"location": schema.StringAttribute{
+				Required:    true,
+				Description: "Folder or file path of the data, relative to the location of the data source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"data_source": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the external data source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_format": schema.StringAttribute{
+				Optional:    true,
+				Description: "Id of the external file format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reject_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How `reject_value` is interpreted: `value` or `percentage`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"value", "percentage"}...),
+				},
+			},
+			"reject_value": schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Number or percentage of rows which can be rejected before the query fails.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"reject_sample_value": schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Number of rows read before the percentage of rejected rows is calculated.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func nullFloat64(value types.Float64) dbsql.NullFloat64 {
+	return dbsql.NullFloat64{Float64: value.ValueFloat64(), Valid: !value.IsNull() && !value.IsUnknown()}
+}
+
+func float64Value(value dbsql.NullFloat64) types.Float64 {
+	if !value.Valid {
+		return types.Float64Null()
+	}
+	return types.Float64Value(value.Float64)
+}
+
+func getExternalTable(model ExternalTableResourceModel) sql.ExternalTable {
+	externalTable := sql.ExternalTable{
+		Location:          model.Location.ValueString(),
+		DataSource:        model.DataSource.ValueString(),
+		FileFormat:        model.FileFormat.ValueString(),
+		RejectType:        dbsql.NullString{String: model.RejectType.ValueString(), Valid: !model.RejectType.IsNull() && !model.RejectType.IsUnknown()},
+		RejectValue:       nullFloat64(model.RejectValue),
+		RejectSampleValue: nullFloat64(model.RejectSampleValue),
+	}
+
+	for _, column := range model.Columns {
+		externalTable.Columns = append(externalTable.Columns, sql.TypeColumn{
+			Name:     column.Name.ValueString(),
+			Type:     column.Type.ValueString(),
+			Nullable: column.Nullable.ValueBool(),
+		})
+	}
+
+	return externalTable
+}
+
+func setState(model *ExternalTableResourceModel, externalTable sql.ExternalTable) {
+	model.Id = types.StringValue(externalTable.Id)
+	model.ObjectId = types.Int64Value(externalTable.ObjectId)
+	model.Name = types.StringValue(externalTable.Name)
+	model.Schema = types.StringValue(externalTable.Schema)
+	model.Location = types.StringValue(externalTable.Location)
+	model.DataSource = types.StringValue(externalTable.DataSource)
+	model.RejectValue = float64Value(externalTable.RejectValue)
+	model.RejectSampleValue = float64Value(externalTable.RejectSampleValue)
+
+	if externalTable.FileFormat == "" {
+		model.FileFormat = types.StringNull()
+	} else {
+		model.FileFormat = types.StringValue(externalTable.FileFormat)
+	}
+
+	if externalTable.RejectType.Valid {
+		model.RejectType = types.StringValue(externalTable.RejectType.String)
+	} else {
+		model.RejectType = types.StringNull()
+	}
+
+	equivalent := len(model.Columns) == len(externalTable.Columns)
+	for i := 0; equivalent && i < len(externalTable.Columns); i++ {
+		configured, actual := model.Columns[i], externalTable.Columns[i]
+		equivalent = configured.Name.ValueString() == actual.Name &&
+			sql.IsTypeDefinitionEquivalent(configured.Type.ValueString(), actual.Type) &&
+			configured.Nullable.ValueBool() == actual.Nullable
+	}
+
+	if !equivalent {
+		model.Columns = []ExternalTableColumnResourceModel{}
+		for _, column := range externalTable.Columns {
+			model.Columns = append(model.Columns, ExternalTableColumnResourceModel{
+				Name:     types.StringValue(column.Name),
+				Type:     types.StringValue(column.Type),
+				Nullable: types.BoolValue(column.Nullable),
+			})
+		}
+	}
+}
+
+func (r *ExternalTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan ExternalTableResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider == "fabric" {
+		logging.AddError(ctx, "Invalid config", "External tables are not supported in Fabric")
+		return
+	}
+
+	externalTable := sql.CreateExternalTable(ctx, connection, name, plan.Schema.ValueString(), getExternalTable(plan))
+
+	if logging.HasError(ctx) {
+		if externalTable.Id != "" {
+			logging.AddError(
+				ctx,
+				"External table already exists",
+				fmt.Sprintf("You can import this resource using `terraform import azuresql_external_table.<name> %s", externalTable.Id))
+		}
+		return
+	}
+
+	setState(&plan, externalTable)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ExternalTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state ExternalTableResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	externalTable := sql.GetExternalTableFromId(ctx, connection, state.Id.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if externalTable.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setState(&state, externalTable)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ExternalTableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *ExternalTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+}
+
+func (r *ExternalTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state ExternalTableResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	sql.DropExternalTable(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Dropping external table failed", fmt.Sprintf("Dropping external table %s failed", state.Name.ValueString()))
+	}
+}
+
+func (r *ExternalTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing external table %s", req.ID))
+
+	externalTable := sql.ParseExternalTableId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, externalTable.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	externalTable = sql.GetExternalTableFromId(ctx, connection, req.ID, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := ExternalTableResourceModel{
+		Database: types.StringValue(externalTable.Connection),
+	}
+	setState(&state, externalTable)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
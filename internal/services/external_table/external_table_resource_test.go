@@ -0,0 +1,98 @@
+package external_table_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type ExternalTableResource struct{}
+
+func TestAccCreateExternalTable(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := ExternalTableResource{}
+
+	connections := []string{
+		data.SynapseDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_external_table.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func (r ExternalTableResource) basic(database string, name string) string {
+	template := r.template(database)
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_external_data_source" "test" {
+			database	= "%[2]s"
+			name		= "tfdatasource_%[3]s"
+			location	= "abfs://test"
+		}
+
+		resource "azuresql_external_file_format" "test" {
+			database	= "%[2]s"
+			name		= "tffileformat_%[3]s"
+			format_type	= "PARQUET"
+		}
+
+		resource "azuresql_external_table" "test" {
+			database	= "%[2]s"
+			name		= "tfexternaltable_%[3]s"
+			schema		= data.azuresql_schema.dbo.id
+			location	= "test/**"
+			data_source	= azuresql_external_data_source.test.id
+			file_format	= azuresql_external_file_format.test.id
+			columns		= [
+				{ name = "id", type = "int", nullable = false },
+				{ name = "name", type = "nvarchar(100)" },
+			]
+		}
+
+		data "azuresql_role" "public" {
+			database 	= "%[2]s"
+			name		= "public"
+		}
+
+		resource "azuresql_permission" "test" {
+			database 	= "%[2]s"
+			scope		= azuresql_external_table.test.id
+			principal	= data.azuresql_role.public.id
+			permission	= "select"
+		}
+		`, template, database, name)
+}
+
+func (r ExternalTableResource) template(database string) string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+
+		data "azuresql_schema" "dbo" {
+			database 	= "%[1]s"
+			name 		= "dbo"
+		}
+	`, database)
+}
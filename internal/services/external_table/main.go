@@ -0,0 +1,26 @@
+package external_table
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ExternalTableColumnResourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Nullable types.Bool   `tfsdk:"nullable"`
+}
+
+type ExternalTableResourceModel struct {
+	Id                types.String                       `tfsdk:"id"`
+	Database          types.String                       `tfsdk:"database"`
+	ObjectId          types.Int64                        `tfsdk:"object_id"`
+	Name              types.String                       `tfsdk:"name"`
+	Schema            types.String                       `tfsdk:"schema"`
+	Columns           []ExternalTableColumnResourceModel `tfsdk:"columns"`
+	Location          types.String                       `tfsdk:"location"`
+	DataSource        types.String                       `tfsdk:"data_source"`
+	FileFormat        types.String                       `tfsdk:"file_format"`
+	RejectType        types.String                       `tfsdk:"reject_type"`
+	RejectValue       types.Float64                      `tfsdk:"reject_value"`
+	RejectSampleValue types.Float64                      `tfsdk:"reject_sample_value"`
+}
@@ -46,8 +46,10 @@ func (r *FunctionResource) SchemaPropertiesAttributes() map[string]attr.Type {
 		"arguments": types.ListType{
 			ElemType: types.ObjectType{
 				AttrTypes: map[string]attr.Type{
-					"name": types.StringType,
-					"type": types.StringType,
+					"name":     types.StringType,
+					"type":     types.StringType,
+					"default":  types.StringType,
+					"readonly": types.BoolType,
 				},
 			},
 		},
@@ -70,6 +72,14 @@ func (r *FunctionResource) SchemaProperties() map[string]schema.Attribute {
 					"type": schema.StringAttribute{
 						Required: true,
 					},
+					"default": schema.StringAttribute{
+						Optional:    true,
+						Description: "Default value of the argument as a T-SQL expression, e.g. `null` or `'text'`.",
+					},
+					"readonly": schema.BoolAttribute{
+						Optional:    true,
+						Description: "If true, the argument can't be modified in the body. Required for table-valued parameters.",
+					},
 				},
 			},
 		},
@@ -112,9 +122,14 @@ func (r *FunctionResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "Name of the function",
+				Description: "Name of the function. When defined with `properties`, renaming the function keeps its object id and permissions.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					// the name is part of a raw definition, which can't be renamed in place
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						var raw types.String
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("raw"), &raw)...)
+						resp.RequiresReplace = !raw.IsNull()
+					}, "Renaming a function defined with raw requires a replace.", "Renaming a function defined with raw requires a replace."),
 				},
 			},
 			"object_id": schema.Int64Attribute{
@@ -142,7 +157,10 @@ func (r *FunctionResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 				Description: "Raw definition of the function.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					// the computed raw definition changes on a rename of a function defined with properties
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.ConfigValue.IsNull()
+					}, "Changing a configured raw definition requires a replace.", "Changing a configured raw definition requires a replace."),
 				},
 				Validators: []validator.String{
 					stringvalidator.ExactlyOneOf(path.Expressions{
@@ -161,8 +179,10 @@ func GetFunctionProps(rm *FunctionPropertiesResourceModel) (props sql.FunctionPr
 		var arguments []sql.FunctionArgument
 		for _, argument := range rm.Arguments {
 			arguments = append(arguments, sql.FunctionArgument{
-				Name: argument.Name.ValueString(),
-				Type: argument.Type.ValueString(),
+				Name:     argument.Name.ValueString(),
+				Type:     argument.Type.ValueString(),
+				Default:  argument.Default.ValueString(),
+				Readonly: argument.Readonly.ValueBool(),
 			})
 		}
 		return sql.FunctionProps{
@@ -187,8 +207,10 @@ func FunctionPropsToResourceModel(props sql.FunctionProps) FunctionPropertiesRes
 	rm.Arguments = []FunctionArgumentResourceModel{}
 	for _, argument := range props.Arguments {
 		rm.Arguments = append(rm.Arguments, FunctionArgumentResourceModel{
-			Name: types.StringValue(argument.Name),
-			Type: types.StringValue(argument.Type),
+			Name:     types.StringValue(argument.Name),
+			Type:     types.StringValue(argument.Type),
+			Default:  types.StringValue(argument.Default),
+			Readonly: types.BoolValue(argument.Readonly),
 		})
 	}
 
@@ -328,9 +350,62 @@ func (r *FunctionResource) Configure(_ context.Context, req resource.ConfigureRe
 	r.ConnectionCache = cache
 }
 
+// Only a rename of a function defined with properties is updated in place, any other change requires a replace.
 func (r *FunctionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
-	logging.AddError(ctx, "Update not implemented", "Update should never be called for function resources as any change requires a delete and recreate.")
+
+	var state, plan FunctionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Properites.IsNull() {
+		logging.AddError(ctx, "Update not implemented", "A function defined with `raw` can't be renamed in place, change the name in the raw definition as well.")
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, state.Database.ValueString(), false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	var planProps FunctionPropertiesResourceModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("properties"), &planProps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sql.RenameObject(ctx, connection, state.ObjectId.ValueInt64(), plan.Name.ValueString())
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	// altering the function updates the name in its definition
+	sql.AlterFunctionFromProperties(ctx, connection, state.Id.ValueString(), GetFunctionProps(&planProps))
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	function := sql.GetFunctionFromId(ctx, connection, state.Id.ValueString(), true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.ObjectId = state.ObjectId
+	plan.Raw = types.StringValue(function.Raw)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *FunctionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -21,8 +21,10 @@ type FunctionPropertiesResourceModel struct {
 	Definition    types.String                    `tfsdk:"definition"`
 }
 type FunctionArgumentResourceModel struct {
-	Name types.String `tfsdk:"name"`
-	Type types.String `tfsdk:"type"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Default  types.String `tfsdk:"default"`
+	Readonly types.Bool   `tfsdk:"readonly"`
 }
 
 type FunctionResourceModel struct {
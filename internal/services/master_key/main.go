@@ -5,7 +5,19 @@ import (
 )
 
 type MasterKeyResourceModel struct {
-	Id       types.String `tfsdk:"id"`
-	Database types.String `tfsdk:"database"`
-	Password types.String `tfsdk:"password"`
+	Id                  types.String `tfsdk:"id"`
+	Database            types.String `tfsdk:"database"`
+	Password            types.String `tfsdk:"password"`
+	PasswordWo          types.String `tfsdk:"password_wo"`
+	PasswordWoVersion   types.Int64  `tfsdk:"password_wo_version"`
+	RegenerationVersion types.Int64  `tfsdk:"regeneration_version"`
+	PasswordPolicy      types.Object `tfsdk:"password_policy"`
+}
+
+type PasswordPolicyResourceModel struct {
+	Length               types.Int64 `tfsdk:"length"`
+	MinSpecialCharacters types.Int64 `tfsdk:"min_special_characters"`
+	MinNumbers           types.Int64 `tfsdk:"min_numbers"`
+	MinUpperCase         types.Int64 `tfsdk:"min_upper_case"`
+	MinLowerCase         types.Int64 `tfsdk:"min_lower_case"`
 }
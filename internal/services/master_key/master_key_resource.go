@@ -7,16 +7,25 @@ import (
 	"terraform-provider-azuresql/internal/logging"
 	"terraform-provider-azuresql/internal/sql"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var (
-	_ resource.Resource              = &MasterKeyResource{}
-	_ resource.ResourceWithConfigure = &MasterKeyResource{}
+	_ resource.Resource               = &MasterKeyResource{}
+	_ resource.ResourceWithConfigure  = &MasterKeyResource{}
+	_ resource.ResourceWithModifyPlan = &MasterKeyResource{}
 )
 
 func NewMasterKeyResource() resource.Resource {
@@ -47,23 +56,146 @@ func (r *MasterKeyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"password": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "Auto generated password for the new master key.",
+				Description: "Password encrypting the master key. A password is generated when not specified.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+				},
 				Sensitive: true,
 			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "Write-only password encrypting the master key, which is never stored in the state. Change password_wo_version to update the password.",
+				Sensitive:   true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of password_wo. The password is only updated when the version changes.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
+			"regeneration_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Change this value to regenerate the master key and all keys it protects.",
+			},
+			"password_policy": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Policy of the generated password. Only used when no password is specified.",
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("password"), path.MatchRoot("password_wo")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"length": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(int64(sql.DefaultPasswordPolicy.Length)),
+						Description: "Length of the generated password.",
+						Validators: []validator.Int64{
+							int64validator.Between(8, 128),
+						},
+					},
+					"min_special_characters": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(int64(sql.DefaultPasswordPolicy.MinSpecialChar)),
+						Description: "Minimum number of special characters.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"min_numbers": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(int64(sql.DefaultPasswordPolicy.MinNum)),
+						Description: "Minimum number of numbers.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"min_upper_case": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(int64(sql.DefaultPasswordPolicy.MinUpperCase)),
+						Description: "Minimum number of upper case letters.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"min_lower_case": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(int64(sql.DefaultPasswordPolicy.MinLowerCase)),
+						Description: "Minimum number of lower case letters.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// Policy used to generate a password, the default policy when not configured.
+func getPasswordPolicy(ctx context.Context, model MasterKeyResourceModel) (sql.PasswordPolicy, diag.Diagnostics) {
+	if model.PasswordPolicy.IsNull() || model.PasswordPolicy.IsUnknown() {
+		return sql.DefaultPasswordPolicy, nil
+	}
+
+	var policy PasswordPolicyResourceModel
+	diags := model.PasswordPolicy.As(ctx, &policy, basetypes.ObjectAsOptions{})
+
+	return sql.PasswordPolicy{
+		Length:         int(policy.Length.ValueInt64()),
+		MinSpecialChar: int(policy.MinSpecialCharacters.ValueInt64()),
+		MinNum:         int(policy.MinNumbers.ValueInt64()),
+		MinUpperCase:   int(policy.MinUpperCase.ValueInt64()),
+		MinLowerCase:   int(policy.MinLowerCase.ValueInt64()),
+	}, diags
+}
+
+func (r MasterKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	// no modification required on delete
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var passwordWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the password isn't stored when a write-only password is used
+	if !passwordWo.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password"), types.StringNull())...)
+	}
+}
+
+// The password is taken from password_wo when specified. Write-only values are only
+// available in the config.
+func getPassword(plan MasterKeyResourceModel, config MasterKeyResourceModel) string {
+	if !config.PasswordWo.IsNull() {
+		return config.PasswordWo.ValueString()
+	}
+	return plan.Password.ValueString()
+}
+
 func (r *MasterKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
 
-	var plan MasterKeyResourceModel
+	var plan, config MasterKeyResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -75,14 +207,22 @@ func (r *MasterKeyResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	masterKey := sql.CreateMasterKey(ctx, connection)
+	policy, diags := getPasswordPolicy(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	masterKey := sql.CreateMasterKey(ctx, connection, getPassword(plan, config), policy)
 
 	if logging.HasError(ctx) {
 		return
 	}
 
 	plan.Id = types.StringValue(masterKey.Id)
-	plan.Password = types.StringValue(masterKey.Password)
+	if config.PasswordWo.IsNull() {
+		plan.Password = types.StringValue(masterKey.Password)
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -141,7 +281,57 @@ func (r *MasterKeyResource) Configure(_ context.Context, req resource.ConfigureR
 
 func (r *MasterKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
-	logging.AddError(ctx, "Update not implemented", "Update should never be called for master key resources.")
+
+	var plan, state, config MasterKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	password := getPassword(plan, config)
+	if password == "" {
+		// switching from a write-only password back to a generated password
+		policy, diags := getPasswordPolicy(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		password = sql.GeneratePassword(ctx, policy)
+		if logging.HasError(ctx) {
+			return
+		}
+		plan.Password = types.StringValue(password)
+	}
+
+	// switching from password to password_wo sets the new password as well, as the state still holds the previous password
+	writeOnlyChanged := !config.PasswordWo.IsNull() && (!plan.PasswordWoVersion.Equal(state.PasswordWoVersion) || !state.Password.IsNull())
+	passwordChanged := config.PasswordWo.IsNull() && !plan.Password.Equal(state.Password)
+
+	switch {
+	case !plan.RegenerationVersion.Equal(state.RegenerationVersion),
+		writeOnlyChanged,
+		passwordChanged && state.Password.ValueString() == "":
+		// the previous password is unknown for write-only passwords, regenerating
+		// the master key removes the encryption by any previous password
+		sql.RegenerateMasterKey(ctx, connection, password)
+	case passwordChanged:
+		sql.ChangeMasterKeyPassword(ctx, connection, state.Password.ValueString(), password)
+	}
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *MasterKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
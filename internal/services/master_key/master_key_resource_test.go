@@ -2,6 +2,7 @@ package master_key_test
 
 import (
 	"fmt"
+	"regexp"
 	"terraform-provider-azuresql/internal/acceptance"
 	"testing"
 
@@ -35,6 +36,84 @@ func TestAccCreateMasterKey(t *testing.T) {
 	}
 }
 
+func TestAccRotateMasterKey(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := MasterKeyResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+		data.SynapseDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.password(connection, "Passw0rd!"+data.RandomString, 1),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check:                    resource.TestCheckResourceAttr("azuresql_master_key.test", "password", "Passw0rd!"+data.RandomString),
+				},
+				{
+					Config:                   r.password(connection, "Passw0rd2!"+data.RandomString, 2),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check:                    resource.TestCheckResourceAttr("azuresql_master_key.test", "password", "Passw0rd2!"+data.RandomString),
+				},
+				{
+					Config:                   r.write_only(connection, "Passw0rd3!"+data.RandomString, 1),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check:                    resource.TestCheckNoResourceAttr("azuresql_master_key.test", "password"),
+				},
+				{
+					Config:                   r.write_only(connection, "Passw0rd4!"+data.RandomString, 2),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check:                    resource.TestCheckResourceAttr("azuresql_master_key.test", "password_wo_version", "2"),
+				},
+			},
+		})
+	}
+}
+
+func TestAccCreateMasterKeyPasswordPolicy(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := MasterKeyResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.password_policy(connection),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check: resource.TestMatchResourceAttr("azuresql_master_key.test", "password",
+						regexp.MustCompile(`^.{32}$`)),
+				},
+			},
+		})
+	}
+}
+
+func (r MasterKeyResource) password_policy(connection string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_master_key" "test" {
+		database 			= "%[2]s"
+		password_policy 	= {
+			length 					= 32
+			min_special_characters 	= 0
+			min_lower_case 			= 5
+		}
+	}
+`, r.template(), connection)
+}
+
 func (r MasterKeyResource) basic(connection string) string {
 	return fmt.Sprintf(`
 	%[1]s
@@ -45,6 +124,30 @@ func (r MasterKeyResource) basic(connection string) string {
 `, r.template(), connection)
 }
 
+func (r MasterKeyResource) password(connection string, password string, regeneration int) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_master_key" "test" {
+		database 				= "%[2]s"
+		password 				= "%[3]s"
+		regeneration_version 	= %[4]d
+	}
+`, r.template(), connection, password, regeneration)
+}
+
+func (r MasterKeyResource) write_only(connection string, password string, version int) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_master_key" "test" {
+		database 				= "%[2]s"
+		password_wo 			= "%[3]s"
+		password_wo_version 	= %[4]d
+	}
+`, r.template(), connection, password, version)
+}
+
 func (r MasterKeyResource) template() string {
 	return fmt.Sprintf(`
 		provider "azuresql" {
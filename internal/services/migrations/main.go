@@ -0,0 +1,21 @@
+package migrations
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type MigrationResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Script   types.String `tfsdk:"script"`
+	Checksum types.String `tfsdk:"checksum"`
+}
+
+type MigrationsResourceModel struct {
+	Id           types.String             `tfsdk:"id"`
+	Database     types.String             `tfsdk:"database"`
+	Schema       types.String             `tfsdk:"schema"`
+	HistoryTable types.String             `tfsdk:"history_table"`
+	ObjectId     types.Int64              `tfsdk:"object_id"`
+	Migrations   []MigrationResourceModel `tfsdk:"migrations"`
+	Applied      types.Map                `tfsdk:"applied"`
+}
@@ -0,0 +1,422 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &MigrationsResource{}
+	_ resource.ResourceWithConfigure      = &MigrationsResource{}
+	_ resource.ResourceWithImportState    = &MigrationsResource{}
+	_ resource.ResourceWithModifyPlan     = &MigrationsResource{}
+	_ resource.ResourceWithValidateConfig = &MigrationsResource{}
+)
+
+func NewMigrationsResource() resource.Resource {
+	return &MigrationsResource{}
+}
+
+type MigrationsResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *MigrationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_migrations"
+}
+
+func (r *MigrationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Versioned schema migrations recorded in a history table.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database where the migrations should be applied.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Required:    true,
+				Description: "Schema in which the migration history table is created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"history_table": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("migration_history"),
+				Description: "Name of the table recording the applied migrations.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Object id of the migration history table in the database.",
+			},
+			"migrations": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Ordered list of migrations.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:    true,
+							Description: "Unique id of the migration.",
+						},
+						"script": schema.StringAttribute{
+							Required:    true,
+							Description: "SQL script of the migration.",
+						},
+						"checksum": schema.StringAttribute{
+							Optional:    true,
+							Description: "Checksum of the migration. Defaults to the sha256 hash of the script.",
+						},
+					},
+				},
+			},
+			"applied": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Checksum of every applied migration, by migration id.",
+			},
+		},
+	}
+}
+
+func getMigrations(models []MigrationResourceModel) (migrations []sql.Migration) {
+	for _, model := range models {
+		migrations = append(migrations, sql.Migration{
+			Id:       model.Id.ValueString(),
+			Script:   model.Script.ValueString(),
+			Checksum: model.Checksum.ValueString(),
+		})
+	}
+	return migrations
+}
+
+// Only keep the applied migrations that are managed by this resource.
+// When no migrations are known (e.g. after an import), all applied migrations are kept.
+func appliedState(ctx context.Context, history sql.MigrationHistory, models []MigrationResourceModel) types.Map {
+	applied := map[string]string{}
+	for id, checksum := range history.Applied {
+		applied[id] = checksum
+	}
+
+	if models != nil {
+		applied = map[string]string{}
+		for _, model := range models {
+			if checksum, ok := history.Applied[model.Id.ValueString()]; ok {
+				applied[model.Id.ValueString()] = checksum
+			}
+		}
+	}
+
+	value, diags := types.MapValueFrom(ctx, types.StringType, applied)
+	logging.AppendDiagnostics(ctx, diags...)
+	return value
+}
+
+func (r MigrationsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var data MigrationsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := map[string]bool{}
+	for _, migration := range data.Migrations {
+		if migration.Id.IsUnknown() {
+			continue
+		}
+		if ids[migration.Id.ValueString()] {
+			logging.AddError(ctx, "Invalid attribute configuration",
+				fmt.Sprintf("Migration id %s is used more than once", migration.Id.ValueString()))
+			return
+		}
+		ids[migration.Id.ValueString()] = true
+	}
+}
+
+func (r MigrationsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	// nothing to plan on delete
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan MigrationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, migration := range plan.Migrations {
+		if migration.Id.IsUnknown() || migration.Script.IsUnknown() || migration.Checksum.IsUnknown() {
+			return
+		}
+	}
+
+	// after apply every configured migration is recorded with its expected checksum
+	expected := map[string]string{}
+	for _, migration := range getMigrations(plan.Migrations) {
+		expected[migration.Id] = migration.ExpectedChecksum()
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state MigrationsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		applied := map[string]string{}
+		resp.Diagnostics.Append(state.Applied.ElementsAs(ctx, &applied, false)...)
+
+		for id, checksum := range applied {
+			if expectedChecksum, ok := expected[id]; ok && expectedChecksum != checksum {
+				logging.AddWarning(ctx, "Checksum mismatch",
+					fmt.Sprintf("Migration %s was applied with checksum %s, but the configured migration has checksum %s. "+
+						"Applying this plan will fail.", id, checksum, expectedChecksum))
+			}
+		}
+	}
+
+	value, diags := types.MapValueFrom(ctx, types.StringType, expected)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("applied"), value)...)
+}
+
+func (r *MigrationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan MigrationsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider != "sqlserver" {
+		logging.AddError(ctx, "Invalid config",
+			"`azuresql_migrations` resource is only supported on SQL databases.")
+		return
+	}
+
+	// An existing history table is reused, so a failed first apply can resume
+	// with the pending migrations.
+	history := sql.GetMigrationHistoryFromNameAndSchema(ctx, connection, plan.HistoryTable.ValueString(), plan.Schema.ValueString(), false)
+	if !logging.HasError(ctx) && history.Id == "" {
+		history = sql.CreateMigrationHistory(ctx, connection, plan.HistoryTable.ValueString(), plan.Schema.ValueString())
+	}
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	history = sql.ApplyMigrations(ctx, connection, history.Id, getMigrations(plan.Migrations))
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	plan.Id = types.StringValue(history.Id)
+	plan.ObjectId = types.Int64Value(history.ObjectId)
+	plan.Applied = appliedState(ctx, history, plan.Migrations)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *MigrationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state MigrationsResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	history := sql.GetMigrationHistoryFromId(ctx, connection, state.Id.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if history.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ObjectId = types.Int64Value(history.ObjectId)
+	state.HistoryTable = types.StringValue(history.Name)
+	state.Schema = types.StringValue(history.Schema)
+	state.Applied = appliedState(ctx, history, state.Migrations)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *MigrationsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *MigrationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state, plan MigrationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, state.Database.ValueString(), false, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	history := sql.ApplyMigrations(ctx, connection, state.Id.ValueString(), getMigrations(plan.Migrations))
+
+	if history.Id == "" {
+		return
+	}
+
+	// the migrations applied before a failure are persisted as well,
+	// the next apply continues with the remaining pending migrations
+	plan.Id = state.Id
+	plan.ObjectId = state.ObjectId
+	plan.Applied = appliedState(ctx, history, plan.Migrations)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MigrationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state MigrationsResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	sql.DropMigrationHistory(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Dropping migration history failed", fmt.Sprintf("Dropping migration history table %s failed", state.HistoryTable.ValueString()))
+	}
+}
+
+func (r *MigrationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing migrations %s", req.ID))
+
+	history := sql.ParseMigrationHistoryId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, history.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	history = sql.GetMigrationHistoryFromId(ctx, connection, req.ID, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := MigrationsResourceModel{
+		Id:           types.StringValue(history.Id),
+		Database:     types.StringValue(history.Connection),
+		Schema:       types.StringValue(history.Schema),
+		HistoryTable: types.StringValue(history.Name),
+		ObjectId:     types.Int64Value(history.ObjectId),
+		Applied:      appliedState(ctx, history, nil),
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
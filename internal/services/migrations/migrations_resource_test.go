@@ -0,0 +1,147 @@
+package migrations_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type MigrationsResource struct{}
+
+func TestAccCreateMigrationsBasic(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := MigrationsResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+
+		defer acceptance.ExecuteSQL(connection, fmt.Sprintf("DROP table if exists dbo.tftable_%s", data.RandomString))
+
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_migrations.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+					ImportStateVerifyIgnore:  []string{"migrations"},
+				},
+				{
+					Config:                   r.added(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.changed(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ExpectError:              regexp.MustCompile("Checksum mismatch"),
+				},
+			},
+		})
+	}
+}
+
+func (r MigrationsResource) basic(connection string, name string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		data "azuresql_schema" "dbo" {
+			database 	= "%[2]s"
+			name 		= "dbo"
+		}
+
+		resource "azuresql_migrations" "test" {
+			database 		= "%[2]s"
+			schema			= data.azuresql_schema.dbo.id
+			history_table	= "tfmigrations_%[3]s"
+			migrations		= [
+				{
+					id		= "0001"
+					script	= "create table dbo.tftable_%[3]s (col1 int)"
+				}
+			]
+		}
+		`, template, connection, name)
+}
+
+func (r MigrationsResource) added(connection string, name string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		data "azuresql_schema" "dbo" {
+			database 	= "%[2]s"
+			name 		= "dbo"
+		}
+
+		resource "azuresql_migrations" "test" {
+			database 		= "%[2]s"
+			schema			= data.azuresql_schema.dbo.id
+			history_table	= "tfmigrations_%[3]s"
+			migrations		= [
+				{
+					id		= "0001"
+					script	= "create table dbo.tftable_%[3]s (col1 int)"
+				},
+				{
+					id		= "0002"
+					script	= <<-EOT
+						alter table dbo.tftable_%[3]s add col2 int
+						GO
+						alter table dbo.tftable_%[3]s add col3 int
+					EOT
+				}
+			]
+		}
+		`, template, connection, name)
+}
+
+func (r MigrationsResource) changed(connection string, name string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		data "azuresql_schema" "dbo" {
+			database 	= "%[2]s"
+			name 		= "dbo"
+		}
+
+		resource "azuresql_migrations" "test" {
+			database 		= "%[2]s"
+			schema			= data.azuresql_schema.dbo.id
+			history_table	= "tfmigrations_%[3]s"
+			migrations		= [
+				{
+					id		= "0001"
+					script	= "create table dbo.tftable_%[3]s (col1 bigint)"
+				}
+			]
+		}
+		`, template, connection, name)
+}
+
+func (r MigrationsResource) template() string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+	`)
+}
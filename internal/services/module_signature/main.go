@@ -0,0 +1,13 @@
+package module_signature
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ModuleSignatureResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Database      types.String `tfsdk:"database"`
+	Module        types.String `tfsdk:"module"`
+	Certificate   types.String `tfsdk:"certificate"`
+	AsymmetricKey types.String `tfsdk:"asymmetric_key"`
+}
@@ -0,0 +1,151 @@
+package module_signature_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type ModuleSignatureResource struct{}
+
+func TestAccCreateModuleSignature(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := ModuleSignatureResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.certificate(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check:                    resource.TestCheckResourceAttr("azuresql_user.test", "authentication", "Certificate"),
+				},
+				{
+					Config:                   r.certificate(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_module_signature.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func TestAccCreateModuleSignatureAsymmetricKey(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := ModuleSignatureResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.asymmetric_key(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+			},
+		})
+	}
+}
+
+func (r ModuleSignatureResource) certificate(connection string, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_certificate" "test" {
+		database 	= "%[2]s"
+		name 		= "tfcertificate_%[3]s"
+		subject 	= "Terraform module signing"
+
+		depends_on = [azuresql_master_key.test]
+	}
+
+	resource "azuresql_user" "test" {
+		database 		= "%[2]s"
+		name 			= "tfcertificateuser_%[3]s"
+		authentication 	= "Certificate"
+		certificate 	= azuresql_certificate.test.id
+	}
+
+	resource "azuresql_permission" "test" {
+		database 	= "%[2]s"
+		scope 		= "%[2]s"
+		principal 	= azuresql_user.test.id
+		permission 	= "view database state"
+	}
+
+	resource "azuresql_module_signature" "test" {
+		database 	= "%[2]s"
+		module 		= azuresql_procedure.test.id
+		certificate = azuresql_certificate.test.id
+	}
+`, r.template(connection, name), connection, name)
+}
+
+func (r ModuleSignatureResource) asymmetric_key(connection string, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "azuresql_asymmetric_key" "test" {
+		database 	= "%[2]s"
+		name 		= "tfkey_%[3]s"
+		algorithm 	= "RSA_2048"
+
+		depends_on = [azuresql_master_key.test]
+	}
+
+	resource "azuresql_user" "test" {
+		database 		= "%[2]s"
+		name 			= "tfkeyuser_%[3]s"
+		authentication 	= "AsymmetricKey"
+		asymmetric_key 	= azuresql_asymmetric_key.test.id
+	}
+
+	resource "azuresql_module_signature" "test" {
+		database 		= "%[2]s"
+		module 			= azuresql_procedure.test.id
+		asymmetric_key 	= azuresql_asymmetric_key.test.id
+	}
+`, r.template(connection, name), connection, name)
+}
+
+func (r ModuleSignatureResource) template(connection string, name string) string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+
+		resource "azuresql_master_key" "test" {
+			database 	= "%[1]s"
+		}
+
+		data "azuresql_schema" "dbo" {
+			database 	= "%[1]s"
+			name 		= "dbo"
+		}
+
+		resource "azuresql_procedure" "test" {
+			database 	= "%[1]s"
+			name        = "tfprocedure_%[2]s"
+			schema		= data.azuresql_schema.dbo.id
+			raw         = <<-EOT
+				create procedure dbo.tfprocedure_%[2]s
+				AS
+				select count(*) as sessions from sys.dm_exec_sessions
+			EOT
+		}
+	`, connection, name)
+}
@@ -65,7 +65,7 @@ func (r *PermissionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"scope": schema.StringAttribute{
 				Required:    true,
-				Description: "Azuresql resource id determining the scope of the permission (table, view, schema, database, server, database scoped credential)",
+				Description: "Azuresql resource id determining the scope of the permission (table, view, column, schema, database, server, database scoped credential)",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -19,8 +19,11 @@ type ProcedurePropertiesResourceModel struct {
 	Definition types.String                     `tfsdk:"definition"`
 }
 type ProcedureArgumentResourceModel struct {
-	Name types.String `tfsdk:"name"`
-	Type types.String `tfsdk:"type"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Default  types.String `tfsdk:"default"`
+	Output   types.Bool   `tfsdk:"output"`
+	Readonly types.Bool   `tfsdk:"readonly"`
 }
 
 type ProcedureResourceModel struct {
@@ -45,8 +45,11 @@ func (r *ProcedureResource) SchemaPropertiesAttributes() map[string]attr.Type {
 		"arguments": types.ListType{
 			ElemType: types.ObjectType{
 				AttrTypes: map[string]attr.Type{
-					"name": types.StringType,
-					"type": types.StringType,
+					"name":     types.StringType,
+					"type":     types.StringType,
+					"default":  types.StringType,
+					"output":   types.BoolType,
+					"readonly": types.BoolType,
 				},
 			},
 		},
@@ -67,6 +70,18 @@ func (r *ProcedureResource) SchemaProperties() map[string]schema.Attribute {
 					"type": schema.StringAttribute{
 						Required: true,
 					},
+					"default": schema.StringAttribute{
+						Optional:    true,
+						Description: "Default value of the argument as a T-SQL expression, e.g. `null` or `'text'`.",
+					},
+					"output": schema.BoolAttribute{
+						Optional:    true,
+						Description: "If true, the argument is an output parameter.",
+					},
+					"readonly": schema.BoolAttribute{
+						Optional:    true,
+						Description: "If true, the argument can't be modified in the body. Required for table-valued parameters.",
+					},
 				},
 			},
 		},
@@ -98,9 +113,14 @@ func (r *ProcedureResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "Name of the procedure",
+				Description: "Name of the procedure. When defined with `properties`, renaming the procedure keeps its object id and permissions.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					// the name is part of a raw definition, which can't be renamed in place
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						var raw types.String
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("raw"), &raw)...)
+						resp.RequiresReplace = !raw.IsNull()
+					}, "Renaming a procedure defined with raw requires a replace.", "Renaming a procedure defined with raw requires a replace."),
 				},
 			},
 			"object_id": schema.Int64Attribute{
@@ -128,7 +148,10 @@ func (r *ProcedureResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				Description: "Raw definition of the procedure.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					// the computed raw definition changes on a rename of a procedure defined with properties
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.ConfigValue.IsNull()
+					}, "Changing a configured raw definition requires a replace.", "Changing a configured raw definition requires a replace."),
 				},
 				Validators: []validator.String{
 					stringvalidator.ExactlyOneOf(path.Expressions{
@@ -147,8 +170,11 @@ func GetProcedureProps(rm *ProcedurePropertiesResourceModel) (props sql.Procedur
 		var arguments []sql.ProcedureArgument
 		for _, argument := range rm.Arguments {
 			arguments = append(arguments, sql.ProcedureArgument{
-				Name: argument.Name.ValueString(),
-				Type: argument.Type.ValueString(),
+				Name:     argument.Name.ValueString(),
+				Type:     argument.Type.ValueString(),
+				Default:  argument.Default.ValueString(),
+				Output:   argument.Output.ValueBool(),
+				Readonly: argument.Readonly.ValueBool(),
 			})
 		}
 		return sql.ProcedureProps{
@@ -170,8 +196,11 @@ func ProcedurePropsToResourceModel(props sql.ProcedureProps) ProcedureProperties
 	rm.Arguments = []ProcedureArgumentResourceModel{}
 	for _, argument := range props.Arguments {
 		rm.Arguments = append(rm.Arguments, ProcedureArgumentResourceModel{
-			Name: types.StringValue(argument.Name),
-			Type: types.StringValue(argument.Type),
+			Name:     types.StringValue(argument.Name),
+			Type:     types.StringValue(argument.Type),
+			Default:  types.StringValue(argument.Default),
+			Output:   types.BoolValue(argument.Output),
+			Readonly: types.BoolValue(argument.Readonly),
 		})
 	}
 
@@ -308,9 +337,62 @@ func (r *ProcedureResource) Configure(_ context.Context, req resource.ConfigureR
 	r.ConnectionCache = cache
 }
 
+// Only a rename of a procedure defined with properties is updated in place, any other change requires a replace.
 func (r *ProcedureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
-	logging.AddError(ctx, "Update not implemented", "Update should never be called for procedure resources as any change requires a delete and recreate.")
+
+	var state, plan ProcedureResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Properites.IsNull() {
+		logging.AddError(ctx, "Update not implemented", "A procedure defined with `raw` can't be renamed in place, change the name in the raw definition as well.")
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, state.Database.ValueString(), false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	var planProps ProcedurePropertiesResourceModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("properties"), &planProps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sql.RenameObject(ctx, connection, state.ObjectId.ValueInt64(), plan.Name.ValueString())
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	// altering the procedure updates the name in its definition
+	sql.AlterProcedureFromProperties(ctx, connection, state.Id.ValueString(), GetProcedureProps(&planProps))
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	procedure := sql.GetProcedureFromId(ctx, connection, state.Id.ValueString(), true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.ObjectId = state.ObjectId
+	plan.Raw = types.StringValue(procedure.Raw)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *ProcedureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
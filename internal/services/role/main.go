@@ -14,10 +14,12 @@ type RoleDataSourceModel struct {
 }
 
 type RoleResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Database    types.String `tfsdk:"database"`
-	Server      types.String `tfsdk:"server"`
-	Name        types.String `tfsdk:"name"`
-	PrincipalId types.Int64  `tfsdk:"principal_id"`
-	Owner       types.String `tfsdk:"owner"`
+	Id              types.String `tfsdk:"id"`
+	Database        types.String `tfsdk:"database"`
+	Server          types.String `tfsdk:"server"`
+	Name            types.String `tfsdk:"name"`
+	PrincipalId     types.Int64  `tfsdk:"principal_id"`
+	Owner           types.String `tfsdk:"owner"`
+	OnDestroy       types.String `tfsdk:"on_destroy"`
+	ReassignOwnedTo types.String `tfsdk:"reassign_owned_to"`
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -76,10 +77,46 @@ func (r *RoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 				Description: "Role or user owning the role.",
 			},
+			"on_destroy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("fail"),
+				Description: "How objects owned by the role and its members are handled when it is dropped. Possible values are `fail`, `reassign_owned_to` and `remove_members`. Defaults to `fail`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("fail", "reassign_owned_to", "remove_members"),
+				},
+			},
+			"reassign_owned_to": schema.StringAttribute{
+				Optional:    true,
+				Description: "Id of the user or role receiving the schemas, objects, types and roles owned by the role when it is dropped. Required when on_destroy equals `reassign_owned_to`, optional when on_destroy equals `remove_members`.",
+			},
 		},
 	}
 }
 
+func (r RoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// remove_members can be combined with reassign_owned_to to transfer owned objects as well
+	if !data.OnDestroy.IsUnknown() && !data.ReassignOwnedTo.IsUnknown() {
+		onDestroy := data.OnDestroy.ValueString()
+		if onDestroy == "reassign_owned_to" && data.ReassignOwnedTo.IsNull() {
+			logging.AddAttributeError(ctx, path.Root("reassign_owned_to"), "Invalid attribute configuration",
+				"reassign_owned_to is required when on_destroy equals `reassign_owned_to`")
+		}
+		if onDestroy != "reassign_owned_to" && onDestroy != "remove_members" && !data.ReassignOwnedTo.IsNull() {
+			logging.AddAttributeError(ctx, path.Root("reassign_owned_to"), "Invalid attribute configuration",
+				"reassign_owned_to is only allowed when on_destroy equals `reassign_owned_to` or `remove_members`")
+		}
+	}
+}
+
 func (r RoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
 
@@ -268,6 +305,9 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		state.Owner = plan.Owner
 	}
 
+	state.OnDestroy = plan.OnDestroy
+	state.ReassignOwnedTo = plan.ReassignOwnedTo
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -297,7 +337,7 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	sql.DropRole(ctx, connection, state.PrincipalId.ValueInt64())
+	sql.DropRole(ctx, connection, state.PrincipalId.ValueInt64(), state.OnDestroy.ValueString(), state.ReassignOwnedTo.ValueString())
 
 	if logging.HasError(ctx) {
 		resp.Diagnostics.AddError("Dropping role failed", fmt.Sprintf("Dropping role %s failed", state.Name.ValueString()))
@@ -337,6 +377,7 @@ func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportState
 		Name:        types.StringValue(role.Name),
 		PrincipalId: types.Int64Value(role.PrincipalId),
 		Owner:       types.StringValue(role.Owner),
+		OnDestroy:   types.StringValue("fail"),
 	}
 
 	if connection.IsServerConnection {
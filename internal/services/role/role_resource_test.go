@@ -161,6 +161,93 @@ func TestAccUpdateRoleOwner(t *testing.T) {
 	}
 }
 
+func TestAccDestroyRoleReassignOwned(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := RoleResource{}
+
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				// the role owns a schema created outside of terraform, which is transferred on destroy
+				Config:                   r.owningSchema(data.SQLDatabase_connection, data.RandomString, "reassign_owned_to", false),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuresql_role.test", "on_destroy", "reassign_owned_to"),
+				),
+			},
+			{
+				Config:                   r.template(),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+			},
+			{
+				Config:                   r.reassignedSchema(data.SQLDatabase_connection, data.RandomString),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.azuresql_schema.owned", "owner", "data.azuresql_user.dbo", "id"),
+				),
+			},
+			{
+				Config:                   r.dropSchema(data.SQLDatabase_connection, data.RandomString),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+			},
+		},
+	})
+}
+
+func TestAccDestroyRoleRemoveMembers(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := RoleResource{}
+
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				// the role has a member added outside of terraform, which is removed on destroy
+				Config:                   r.withMember(data.SQLDatabase_connection, data.RandomString),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuresql_role.test", "on_destroy", "remove_members"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDestroyRoleRemoveMembersReassignOwned(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := RoleResource{}
+
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				// the role owns a schema and has a member, both added outside of terraform
+				Config:                   r.owningSchema(data.SQLDatabase_connection, data.RandomString, "remove_members", true),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuresql_role.test", "on_destroy", "remove_members"),
+				),
+			},
+			{
+				Config:                   r.template(),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+			},
+			{
+				Config:                   r.reassignedSchema(data.SQLDatabase_connection, data.RandomString),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.azuresql_schema.owned", "owner", "data.azuresql_user.dbo", "id"),
+				),
+			},
+			{
+				Config:                   r.dropSchema(data.SQLDatabase_connection, data.RandomString),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+			},
+		},
+	})
+}
+
 func (r RoleResource) basic(connection string, name string) string {
 	template := r.template()
 
@@ -212,6 +299,110 @@ func (r RoleResource) updateOwner(connection string, name string, owner int) str
 		`, r.template(), acceptance.TerraformConnectionId(connection), name, owner)
 }
 
+func (r RoleResource) owningSchema(connection string, name string, onDestroy string, withMember bool) string {
+	template := r.template()
+
+	member := ""
+	if withMember {
+		member = fmt.Sprintf(`
+		resource "azuresql_role" "member" {
+			database = "%[1]s"
+			name     = "tfrole_member_%[2]s"
+		}
+
+		data "azuresql_execute_sql" "member" {
+			database   = "%[1]s"
+			sql        = "alter role [tfrole_%[2]s] add member [tfrole_member_%[2]s]"
+			depends_on = [azuresql_role.test, azuresql_role.member]
+		}
+		`, connection, name)
+	}
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		data "azuresql_user" "dbo" {
+			database = "%[2]s"
+			name     = "dbo"
+		}
+
+		resource "azuresql_role" "test" {
+			database          = "%[2]s"
+			name              = "tfrole_%[3]s"
+			on_destroy        = "%[4]s"
+			reassign_owned_to = data.azuresql_user.dbo.id
+		}
+
+		data "azuresql_execute_sql" "schema" {
+			database   = "%[2]s"
+			sql        = "IF SCHEMA_ID('tfschema_%[3]s') IS NULL EXEC('create schema [tfschema_%[3]s] authorization [tfrole_%[3]s]')"
+			depends_on = [azuresql_role.test]
+		}
+		%[5]s
+		`, template, connection, name, onDestroy, member)
+}
+
+// reassignedSchema reads the schema left behind by owningSchema once the role is dropped
+func (r RoleResource) reassignedSchema(connection string, name string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		data "azuresql_user" "dbo" {
+			database = "%[2]s"
+			name     = "dbo"
+		}
+
+		data "azuresql_schema" "owned" {
+			database = "%[2]s"
+			name     = "tfschema_%[3]s"
+		}
+		`, template, connection, name)
+}
+
+func (r RoleResource) dropSchema(connection string, name string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		data "azuresql_execute_sql" "drop_schema" {
+			database = "%[2]s"
+			sql      = "drop schema if exists [tfschema_%[3]s]"
+		}
+		`, template, connection, name)
+}
+
+func (r RoleResource) withMember(connection string, name string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_role" "member" {
+			database = "%[2]s"
+			name     = "tfrole_member_%[3]s"
+		}
+
+		resource "azuresql_role" "test" {
+			database   = "%[2]s"
+			name       = "tfrole_%[3]s"
+			on_destroy = "remove_members"
+		}
+
+		data "azuresql_execute_sql" "member" {
+			database   = "%[2]s"
+			sql        = "alter role [tfrole_%[3]s] add member [tfrole_member_%[3]s]"
+			depends_on = [azuresql_role.test, azuresql_role.member]
+		}
+		`, template, connection, name)
+}
+
 func (r RoleResource) template() string {
 	return fmt.Sprintf(`
 		provider "azuresql" {
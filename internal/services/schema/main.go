@@ -13,9 +13,10 @@ type SchemaDataSourceModel struct {
 }
 
 type SchemaResourceModel struct {
-	Id       types.String `tfsdk:"id"`
-	Database types.String `tfsdk:"database"`
-	Name     types.String `tfsdk:"name"`
-	Owner    types.String `tfsdk:"owner"`
-	SchemaId types.Int64  `tfsdk:"schema_id"`
+	Id           types.String `tfsdk:"id"`
+	Database     types.String `tfsdk:"database"`
+	Name         types.String `tfsdk:"name"`
+	Owner        types.String `tfsdk:"owner"`
+	SchemaId     types.Int64  `tfsdk:"schema_id"`
+	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -41,6 +43,9 @@ func (r *SchemaResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Unique identifier for terraform used to import the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"database": schema.StringAttribute{
 				Required:    true,
@@ -51,20 +56,26 @@ func (r *SchemaResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "Name of the schema.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Description: "Name of the schema. Renaming transfers the contents to a new schema, which changes the schema id.",
 			},
 			"schema_id": schema.Int64Attribute{
 				Computed:    true,
 				Description: "Schema ID of the schema in the database.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"owner": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
 				Description: "Principal owning the schema.",
 			},
+			"force_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When `true`, the objects, types and xml schema collections in the schema are dropped before the schema is dropped. Defaults to `false`.",
+			},
 		},
 	}
 }
@@ -84,13 +95,27 @@ func (r SchemaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		return
 	}
 
+	var plan SchemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a rename creates a new schema
+	// -> the id is only known after the update
+	if !plan.Name.Equal(state.Name) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("schema_id"), types.Int64Unknown())...)
+	}
+
 	database := state.Database.ValueString()
 	connection := r.ConnectionCache.Connect(ctx, database, false, false)
 
-	// in Synapse serverless alter authorization cannot be used
-	// -> a replace is required when owner changes
+	// in Synapse serverless alter authorization and transfers cannot be used
+	// -> a replace is required when owner or name changes
 	if connection.Provider == "synapse" {
 		resp.RequiresReplace.Append(path.Root("owner"))
+		resp.RequiresReplace.Append(path.Root("name"))
 	}
 }
 
@@ -225,8 +250,22 @@ func (r *SchemaResource) Update(ctx context.Context, req resource.UpdateRequest,
 	connection := r.ConnectionCache.Connect(ctx, database, false, true)
 	id := state.Id.ValueString()
 
+	// rename by transferring the contents to a new schema
+	if !state.Name.Equal(plan.Name) {
+		schema := sql.RenameSchema(ctx, connection, id, plan.Name.ValueString())
+
+		if logging.HasError(ctx) {
+			return
+		}
+		state.Id = types.StringValue(schema.Id)
+		state.SchemaId = types.Int64Value(schema.SchemaId)
+		state.Name = types.StringValue(schema.Name)
+		state.Owner = types.StringValue(schema.Owner)
+		id = schema.Id
+	}
+
 	// update owner
-	if !state.Owner.Equal(plan.Owner) {
+	if !plan.Owner.IsUnknown() && !state.Owner.Equal(plan.Owner) {
 		sql.UpdateSchemaOwner(ctx, connection, id, plan.Owner.ValueString())
 
 		if logging.HasError(ctx) {
@@ -235,6 +274,8 @@ func (r *SchemaResource) Update(ctx context.Context, req resource.UpdateRequest,
 		state.Owner = plan.Owner
 	}
 
+	state.ForceDestroy = plan.ForceDestroy
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -263,7 +304,7 @@ func (r *SchemaResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	sql.DropSchema(ctx, connection, state.SchemaId.ValueInt64())
+	sql.DropSchema(ctx, connection, state.SchemaId.ValueInt64(), state.ForceDestroy.ValueBool())
 
 	if logging.HasError(ctx) {
 		resp.Diagnostics.AddError("Dropping schema failed", fmt.Sprintf("Dropping schema %s failed", state.Name.ValueString()))
@@ -293,11 +334,12 @@ func (r *SchemaResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 
 	state := SchemaResourceModel{
-		Id:       types.StringValue(schema.Id),
-		Database: types.StringValue(schema.Connection),
-		Name:     types.StringValue(schema.Name),
-		Owner:    types.StringValue(schema.Owner),
-		SchemaId: types.Int64Value(schema.SchemaId),
+		Id:           types.StringValue(schema.Id),
+		Database:     types.StringValue(schema.Connection),
+		Name:         types.StringValue(schema.Name),
+		Owner:        types.StringValue(schema.Owner),
+		SchemaId:     types.Int64Value(schema.SchemaId),
+		ForceDestroy: types.BoolValue(false),
 	}
 
 	diags := resp.State.Set(ctx, &state)
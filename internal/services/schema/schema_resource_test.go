@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 type SchemaResource struct{}
@@ -133,6 +134,55 @@ func TestAccUpdateSchemaOwner(t *testing.T) {
 	}
 }
 
+func TestAccRenameSchema(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := SchemaResource{}
+
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:                   r.basic(data.SQLDatabase_connection, data.RandomString),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuresql_schema.test", "name", "tfschema_"+data.RandomString),
+				),
+			},
+			{
+				Config:                   r.basic(data.SQLDatabase_connection, data.RandomString+"_renamed"),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("azuresql_schema.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuresql_schema.test", "name", "tfschema_"+data.RandomString+"_renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccForceDestroySchema(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := SchemaResource{}
+
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				// objects created outside of terraform are dropped with the schema on destroy
+				Config:                   r.forceDestroy(data.SQLDatabase_connection, data.RandomString),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuresql_schema.test", "force_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
 func (r SchemaResource) basic(connection string, name string) string {
 	template := r.template()
 
@@ -203,6 +253,38 @@ func (r SchemaResource) duplicate_schema(connection string, name string) string
 		`, template, connection, name)
 }
 
+func (r SchemaResource) forceDestroy(connection string, name string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_schema" "test" {
+			database      = "%[2]s"
+			name          = "tfschema_%[3]s"
+			force_destroy = true
+		}
+
+		data "azuresql_execute_sql" "objects" {
+			database   = "%[2]s"
+			sql        = <<-EOT
+				IF OBJECT_ID('tfschema_%[3]s.customers', 'U') IS NULL
+				BEGIN
+					create table tfschema_%[3]s.customers (id int primary key)
+					create table tfschema_%[3]s.orders (id int primary key, customer_id int references tfschema_%[3]s.customers(id))
+					alter table tfschema_%[3]s.customers add last_order_id int references tfschema_%[3]s.orders(id)
+					EXEC('create view tfschema_%[3]s.order_count with schemabinding as select count_big(*) as orders from tfschema_%[3]s.orders')
+					EXEC('create procedure tfschema_%[3]s.get_order_count as select orders from tfschema_%[3]s.order_count')
+					EXEC('create type tfschema_%[3]s.amount from decimal(10, 2)')
+					EXEC('create type tfschema_%[3]s.order_lines as table (amount tfschema_%[3]s.amount)')
+				END
+			EOT
+			depends_on = [azuresql_schema.test]
+		}
+		`, template, connection, name)
+}
+
 func (r SchemaResource) template() string {
 	return fmt.Sprintf(`
 		provider "azuresql" {
@@ -0,0 +1,18 @@
+package sensitivity_classification
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SensitivityClassificationResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Database          types.String `tfsdk:"database"`
+	Table             types.String `tfsdk:"table"`
+	Column            types.String `tfsdk:"column"`
+	ColumnId          types.Int64  `tfsdk:"column_id"`
+	Label             types.String `tfsdk:"label"`
+	LabelId           types.String `tfsdk:"label_id"`
+	InformationType   types.String `tfsdk:"information_type"`
+	InformationTypeId types.String `tfsdk:"information_type_id"`
+	Rank              types.String `tfsdk:"rank"`
+}
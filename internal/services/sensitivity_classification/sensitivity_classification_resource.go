@@ -0,0 +1,359 @@
+package sensitivity_classification
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &SensitivityClassificationResource{}
+	_ resource.ResourceWithConfigure   = &SensitivityClassificationResource{}
+	_ resource.ResourceWithImportState = &SensitivityClassificationResource{}
+)
+
+func NewSensitivityClassificationResource() resource.Resource {
+	return &SensitivityClassificationResource{}
+}
+
+type SensitivityClassificationResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *SensitivityClassificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sensitivity_classification"
+}
+
+func (r *SensitivityClassificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sensitivity classification of a table column.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database of the classified table.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the azuresql_table containing the classified column.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"column": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the classified column.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"column_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the classified column in the table.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Optional:    true,
+				Description: "Sensitivity label, e.g. `Confidential`.",
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("information_type")),
+				},
+			},
+			"label_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Identifier of the sensitivity label, e.g. the GUID of the Microsoft Purview Information Protection label.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("label")),
+				},
+			},
+			"information_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Information type, e.g. `Contact Info`.",
+			},
+			"information_type_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Identifier of the information type.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("information_type")),
+				},
+			},
+			"rank": schema.StringAttribute{
+				Optional:    true,
+				Description: "Sensitivity rank. Possible values are `NONE`, `LOW`, `MEDIUM`, `HIGH` and `CRITICAL`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("NONE", "LOW", "MEDIUM", "HIGH", "CRITICAL"),
+				},
+			},
+		},
+	}
+}
+
+func toSqlClassification(plan SensitivityClassificationResourceModel) sql.SensitivityClassification {
+	return sql.SensitivityClassification{
+		Table:             plan.Table.ValueString(),
+		Column:            plan.Column.ValueString(),
+		Label:             plan.Label.ValueString(),
+		LabelId:           plan.LabelId.ValueString(),
+		InformationType:   plan.InformationType.ValueString(),
+		InformationTypeId: plan.InformationTypeId.ValueString(),
+		Rank:              plan.Rank.ValueString(),
+	}
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func setState(state *SensitivityClassificationResourceModel, classification sql.SensitivityClassification) {
+	state.Id = types.StringValue(classification.Id)
+	state.Table = types.StringValue(classification.Table)
+	state.Column = types.StringValue(classification.Column)
+	state.ColumnId = types.Int64Value(classification.ColumnId)
+	state.Label = optionalString(classification.Label)
+	state.LabelId = optionalString(classification.LabelId)
+	state.InformationType = optionalString(classification.InformationType)
+	state.InformationTypeId = optionalString(classification.InformationTypeId)
+	state.Rank = optionalString(classification.Rank)
+}
+
+func (r *SensitivityClassificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan SensitivityClassificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider != "sqlserver" {
+		logging.AddError(ctx, "Invalid config",
+			"`azuresql_sensitivity_classification` resource is only supported on SQL databases.")
+		return
+	}
+
+	classification := sql.CreateSensitivityClassification(ctx, connection, toSqlClassification(plan))
+
+	if logging.HasError(ctx) {
+		if classification.Id != "" {
+			logging.AddError(
+				ctx,
+				"Sensitivity classification already exists",
+				fmt.Sprintf("You can import this resource using `terraform import azuresql_sensitivity_classification.<name> %s", classification.Id))
+		}
+		return
+	}
+
+	setState(&plan, classification)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SensitivityClassificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state SensitivityClassificationResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	classification := sql.GetSensitivityClassificationFromId(ctx, connection, state.Id.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if classification.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setState(&state, classification)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SensitivityClassificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *SensitivityClassificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan SensitivityClassificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	classification := sql.ParseSensitivityClassificationId(ctx, plan.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	update := toSqlClassification(plan)
+	update.ObjectId = classification.ObjectId
+	update.ColumnId = classification.ColumnId
+
+	sql.UpdateSensitivityClassification(ctx, connection, update)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	classification = sql.GetSensitivityClassificationFromId(ctx, connection, plan.Id.ValueString(), true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	setState(&plan, classification)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SensitivityClassificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state SensitivityClassificationResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	sql.DropSensitivityClassification(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Dropping sensitivity classification failed", fmt.Sprintf("Dropping sensitivity classification of column %s failed", state.Column.ValueString()))
+	}
+}
+
+func (r *SensitivityClassificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing sensitivity classification %s", req.ID))
+
+	classification := sql.ParseSensitivityClassificationId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, classification.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	classification = sql.GetSensitivityClassificationFromId(ctx, connection, req.ID, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := SensitivityClassificationResourceModel{
+		Database: types.StringValue(classification.Connection),
+	}
+	setState(&state, classification)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,89 @@
+package sensitivity_classification_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type SensitivityClassificationResource struct{}
+
+func TestAccCreateSensitivityClassification(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := SensitivityClassificationResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+
+		acceptance.ExecuteSQL(connection, fmt.Sprintf("create table dbo.tftable_%s (email varchar(100))", data.RandomString))
+		defer acceptance.ExecuteSQL(connection, fmt.Sprintf("DROP table dbo.tftable_%s", data.RandomString))
+
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.basic(connection, data.RandomString, "Confidential", "MEDIUM"),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("azuresql_sensitivity_classification.test", "label", "Confidential"),
+						resource.TestCheckResourceAttr("azuresql_sensitivity_classification.test", "rank", "MEDIUM"),
+					),
+				},
+				{
+					Config:                   r.basic(connection, data.RandomString, "Confidential", "MEDIUM"),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_sensitivity_classification.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+				{
+					Config:                   r.basic(connection, data.RandomString, "Highly Confidential", "HIGH"),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("azuresql_sensitivity_classification.test", "label", "Highly Confidential"),
+						resource.TestCheckResourceAttr("azuresql_sensitivity_classification.test", "rank", "HIGH"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func (r SensitivityClassificationResource) basic(connection string, name string, label string, rank string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	data "azuresql_schema" "dbo" {
+		database 	= "%[2]s"
+		name 		= "dbo"
+	}
+
+	data "azuresql_table" "test" {
+		database 	= "%[2]s"
+		schema 		= data.azuresql_schema.dbo.id
+		name 		= "tftable_%[3]s"
+	}
+
+	resource "azuresql_sensitivity_classification" "test" {
+		database 			= "%[2]s"
+		table 				= data.azuresql_table.test.id
+		column 				= "email"
+		label 				= "%[4]s"
+		information_type 	= "Contact Info"
+		rank 				= "%[5]s"
+	}
+`, r.template(), connection, name, label, rank)
+}
+
+func (r SensitivityClassificationResource) template() string {
+	return `
+		provider "azuresql" {
+		}
+	`
+}
@@ -0,0 +1,22 @@
+package sequence
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SequenceResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Database     types.String `tfsdk:"database"`
+	ObjectId     types.Int64  `tfsdk:"object_id"`
+	Name         types.String `tfsdk:"name"`
+	Schema       types.String `tfsdk:"schema"`
+	DataType     types.String `tfsdk:"data_type"`
+	StartValue   types.Int64  `tfsdk:"start_value"`
+	Increment    types.Int64  `tfsdk:"increment"`
+	MinValue     types.Int64  `tfsdk:"min_value"`
+	MaxValue     types.Int64  `tfsdk:"max_value"`
+	Cycle        types.Bool   `tfsdk:"cycle"`
+	Cache        types.Bool   `tfsdk:"cache"`
+	CacheSize    types.Int64  `tfsdk:"cache_size"`
+	CurrentValue types.Int64  `tfsdk:"current_value"`
+}
@@ -0,0 +1,400 @@
+package sequence
+
+import (
+	"context"
+	dbsql "database/sql"
+	"fmt"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &SequenceResource{}
+	_ resource.ResourceWithConfigure   = &SequenceResource{}
+	_ resource.ResourceWithImportState = &SequenceResource{}
+)
+
+func NewSequenceResource() resource.Resource {
+	return &SequenceResource{}
+}
+
+type SequenceResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *SequenceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sequence"
+}
+
+func (r *SequenceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Database sequence.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database where the sequence should be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the sequence",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the sequence object in the database",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Required:    true,
+				Description: "Schema where the sequence resides.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"data_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("bigint"),
+				Description: "Integer type of the sequence: `tinyint`, `smallint`, `int` or `bigint`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"tinyint", "smallint", "int", "bigint"}...),
+				},
+			},
+			"start_value": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "First value returned by the sequence. Changing the start value restarts the sequence.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"increment": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Description: "Value by which the sequence is incremented. A negative value results in a descending sequence.",
+			},
+			"min_value": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Minimum value of the sequence. Defaults to the minimum of the data type.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_value": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum value of the sequence. Defaults to the maximum of the data type.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cycle": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "If true, the sequence restarts from the minimum (or maximum for descending sequences) when its limit is exceeded.",
+			},
+			"cache": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "If true, sequence values are cached in memory to improve performance.",
+			},
+			"cache_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of cached sequence values. Uses the database default when not set.",
+			},
+			"current_value": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Last value returned by the sequence.",
+			},
+		},
+	}
+}
+
+func getSequence(model SequenceResourceModel) (sequence sql.Sequence) {
+	sequence = sql.Sequence{
+		DataType:  model.DataType.ValueString(),
+		Increment: model.Increment.ValueInt64(),
+		Cycle:     model.Cycle.ValueBool(),
+		Cache:     model.Cache.ValueBool(),
+		CacheSize: dbsql.NullInt64{Int64: model.CacheSize.ValueInt64(), Valid: !model.CacheSize.IsNull()},
+	}
+
+	min, max := sql.SequenceTypeRange(sequence.DataType)
+
+	sequence.MinValue = min
+	if !model.MinValue.IsNull() && !model.MinValue.IsUnknown() {
+		sequence.MinValue = model.MinValue.ValueInt64()
+	}
+
+	sequence.MaxValue = max
+	if !model.MaxValue.IsNull() && !model.MaxValue.IsUnknown() {
+		sequence.MaxValue = model.MaxValue.ValueInt64()
+	}
+
+	// ascending sequences start at the minimum, descending sequences at the maximum
+	sequence.StartValue = sequence.MinValue
+	if sequence.Increment < 0 {
+		sequence.StartValue = sequence.MaxValue
+	}
+	if !model.StartValue.IsNull() && !model.StartValue.IsUnknown() {
+		sequence.StartValue = model.StartValue.ValueInt64()
+	}
+
+	return sequence
+}
+
+func setState(model *SequenceResourceModel, sequence sql.Sequence) {
+	model.Id = types.StringValue(sequence.Id)
+	model.ObjectId = types.Int64Value(sequence.ObjectId)
+	model.Name = types.StringValue(sequence.Name)
+	model.Schema = types.StringValue(sequence.Schema)
+	model.DataType = types.StringValue(sequence.DataType)
+	model.StartValue = types.Int64Value(sequence.StartValue)
+	model.Increment = types.Int64Value(sequence.Increment)
+	model.MinValue = types.Int64Value(sequence.MinValue)
+	model.MaxValue = types.Int64Value(sequence.MaxValue)
+	model.Cycle = types.BoolValue(sequence.Cycle)
+	model.Cache = types.BoolValue(sequence.Cache)
+	model.CurrentValue = types.Int64Value(sequence.CurrentValue)
+
+	if sequence.CacheSize.Valid {
+		model.CacheSize = types.Int64Value(sequence.CacheSize.Int64)
+	} else {
+		model.CacheSize = types.Int64Null()
+	}
+}
+
+func (r *SequenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan SequenceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider != "sqlserver" {
+		logging.AddError(ctx, "Invalid config",
+			"`azuresql_sequence` resource is only supported on SQL databases.")
+		return
+	}
+
+	sequence := sql.CreateSequence(ctx, connection, name, plan.Schema.ValueString(), getSequence(plan))
+
+	if logging.HasError(ctx) {
+		if sequence.Id != "" {
+			logging.AddError(
+				ctx,
+				"Sequence already exists",
+				fmt.Sprintf("You can import this resource using `terraform import azuresql_sequence.<name> %s", sequence.Id))
+		}
+		return
+	}
+
+	setState(&plan, sequence)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SequenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state SequenceResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	sequence := sql.GetSequenceFromId(ctx, connection, state.Id.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if sequence.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setState(&state, sequence)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SequenceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *SequenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state, plan SequenceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, state.Database.ValueString(), false, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	restart := !plan.StartValue.IsUnknown() && !plan.StartValue.Equal(state.StartValue)
+
+	sql.UpdateSequence(ctx, connection, state.Id.ValueString(), getSequence(plan), restart)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	sequence := sql.GetSequenceFromId(ctx, connection, state.Id.ValueString(), true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	setState(&plan, sequence)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SequenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state SequenceResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	sql.DropSequence(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Dropping sequence failed", fmt.Sprintf("Dropping sequence %s failed", state.Name.ValueString()))
+	}
+}
+
+func (r *SequenceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing sequence %s", req.ID))
+
+	sequence := sql.ParseSequenceId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, sequence.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	sequence = sql.GetSequenceFromId(ctx, connection, req.ID, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := SequenceResourceModel{
+		Database: types.StringValue(sequence.Connection),
+	}
+	setState(&state, sequence)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,122 @@
+package sequence_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type SequenceResource struct{}
+
+func TestAccCreateSequenceBasic(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := SequenceResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_sequence.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func TestAccUpdateSequence(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := SequenceResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.with_options(connection, data.RandomString, 1000, 1),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.with_options(connection, data.RandomString, 2000, 10),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+			},
+		})
+	}
+}
+
+func (r SequenceResource) basic(connection string, name string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		data "azuresql_schema" "dbo" {
+			database 	= "%[2]s"
+			name 		= "dbo"
+		}
+
+		resource "azuresql_sequence" "test" {
+			database 	= "%[2]s"
+			name        = "tfsequence_%[3]s"
+			schema		= data.azuresql_schema.dbo.id
+		}
+		`, template, connection, name)
+}
+
+func (r SequenceResource) with_options(connection string, name string, start int, increment int) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		data "azuresql_schema" "dbo" {
+			database 	= "%[2]s"
+			name 		= "dbo"
+		}
+
+		resource "azuresql_sequence" "test" {
+			database 	= "%[2]s"
+			name        = "tfsequence_%[3]s"
+			schema		= data.azuresql_schema.dbo.id
+			data_type	= "int"
+			start_value	= %[4]d
+			increment	= %[5]d
+			min_value	= 1
+			max_value	= 1000000
+			cycle		= true
+			cache_size	= 20
+		}
+		`, template, connection, name, start, increment)
+}
+
+func (r SequenceResource) template() string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+	`)
+}
@@ -5,16 +5,29 @@ import (
 )
 
 type SQLLoginDataSourceModel struct {
-	Id     types.String `tfsdk:"id"`
-	Server types.String `tfsdk:"server"`
-	Name   types.String `tfsdk:"name"`
-	Sid    types.String `tfsdk:"sid"`
+	Id                types.String `tfsdk:"id"`
+	Server            types.String `tfsdk:"server"`
+	Name              types.String `tfsdk:"name"`
+	Sid               types.String `tfsdk:"sid"`
+	Authentication    types.String `tfsdk:"authentication"`
+	EntraIDIdentifier types.String `tfsdk:"entraid_identifier"`
 }
 
 type SQLLoginResourceModel struct {
-	Id       types.String `tfsdk:"id"`
-	Server   types.String `tfsdk:"server"`
-	Name     types.String `tfsdk:"name"`
-	Password types.String `tfsdk:"password"`
-	Sid      types.String `tfsdk:"sid"`
+	Id                types.String `tfsdk:"id"`
+	Server            types.String `tfsdk:"server"`
+	Name              types.String `tfsdk:"name"`
+	Password          types.String `tfsdk:"password"`
+	Sid               types.String `tfsdk:"sid"`
+	Authentication    types.String `tfsdk:"authentication"`
+	EntraIDIdentifier types.String `tfsdk:"entraid_identifier"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+	OldPasswordWo     types.String `tfsdk:"old_password_wo"`
+	MustChange        types.Bool   `tfsdk:"must_change"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	CheckPolicy       types.Bool   `tfsdk:"check_policy"`
+	CheckExpiration   types.Bool   `tfsdk:"check_expiration"`
+	DefaultDatabase   types.String `tfsdk:"default_database"`
+	DefaultLanguage   types.String `tfsdk:"default_language"`
 }
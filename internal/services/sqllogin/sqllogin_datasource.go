@@ -50,6 +50,14 @@ func (d *providerConfig) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Required:    true,
 				Description: "Login name",
 			},
+			"authentication": schema.StringAttribute{
+				Computed:    true,
+				Description: "Authentication of the login, `SQL` or `AzureAD`.",
+			},
+			"entraid_identifier": schema.StringAttribute{
+				Computed:    true,
+				Description: "Object id or application id of the Entra ID identity of an `AzureAD` login.",
+			},
 		},
 	}
 }
@@ -86,6 +94,11 @@ func (r *providerConfig) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	state.Sid = types.StringValue(login.Sid)
 	state.Id = types.StringValue(login.Id)
+	state.Authentication = types.StringValue(login.Authentication)
+	state.EntraIDIdentifier = types.StringNull()
+	if login.EntraIDIdentifier != "" {
+		state.EntraIDIdentifier = types.StringValue(login.EntraIDIdentifier)
+	}
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
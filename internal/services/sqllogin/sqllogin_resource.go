@@ -2,15 +2,26 @@ package login
 
 import (
 	"context"
+	dbsql "database/sql"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"terraform-provider-azuresql/internal/logging"
 	"terraform-provider-azuresql/internal/sql"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,6 +32,8 @@ import (
 	_ resource.ResourceWithImportState = &Resource{}
 )*/
 
+var sidRegex = regexp.MustCompile(`^0x[0-9A-Fa-f]+$`)
+
 func NewSQLLoginResource() resource.Resource {
 	return &SQLLoginResource{}
 }
@@ -50,39 +63,254 @@ func (r *SQLLoginResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"sid": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "sid assocatied to the login on the server",
+				Description: "sid assocatied to the login on the server. Set it to create a login with the same sid on multiple servers.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(sidRegex, "sid should be a binary constant, e.g. 0x0106000000000064000000000000000028B1F8C5B1B0A74E"),
 				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "Login name",
+				Description: "Login name. Changing the name renames the login, keeping its sid.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Password for the login. Only supported for `SQL` authentication, generated when not set. Changing the password rotates it in place.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+				},
+				Sensitive: true,
 			},
-			"password": schema.StringAttribute{
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "Write-only password for the login, which is never stored in the state. Change password_wo_version to rotate the password.",
+				Sensitive:   true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of password_wo. The password is only rotated when the version changes.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
+			"old_password_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "Write-only current password of the login, passed as `old_password` when rotating the password. Only required when the provider lacks the `alter any login` permission.",
+				Sensitive:   true,
+			},
+			"must_change": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Require the password to be changed on the next login whenever the password is set or rotated. Requires check_policy and check_expiration.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
 				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the login can connect. Disabling a login keeps the login and its users. Defaults to `true`.",
+			},
+			"check_policy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Enforce the Windows password policy of the server. Only supported for `SQL` authentication.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"check_expiration": schema.BoolAttribute{
 				Optional:    true,
-				Description: "Password for the new login.",
+				Computed:    true,
+				Description: "Enforce the password expiration policy of the server. Requires check_policy. Only supported for `SQL` authentication.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_database": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Default database of the login.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_language": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Default language of the login.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"authentication": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("SQL"),
+				Description: "Authentication of the login. Possible values are `SQL` for a password login and `AzureAD` for an Entra ID login. Defaults to `SQL`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("SQL", "AzureAD"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entraid_identifier": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Object id of the Entra ID user or group, or application (client) id of the service principal. When not set, the Entra ID identity is resolved by name.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
-				Sensitive: true,
 			},
 		},
 	}
 }
 
+func (r SQLLoginResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var data SQLLoginResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Authentication.IsUnknown() {
+		return
+	}
+
+	entraid := data.Authentication.ValueString() == "AzureAD"
+
+	if entraid && !data.Password.IsNull() {
+		logging.AddAttributeError(ctx, path.Root("password"), "Invalid attribute configuration",
+			"password is only supported when authentication equals `SQL`")
+	}
+
+	if entraid && !data.Sid.IsNull() {
+		logging.AddAttributeError(ctx, path.Root("sid"), "Invalid attribute configuration",
+			"sid is only supported when authentication equals `SQL`, the sid of an Entra ID login is derived from entraid_identifier")
+	}
+
+	if !entraid && !data.EntraIDIdentifier.IsNull() {
+		logging.AddAttributeError(ctx, path.Root("entraid_identifier"), "Invalid attribute configuration",
+			"entraid_identifier is only supported when authentication equals `AzureAD`")
+	}
+
+	for name, value := range map[string]attr.Value{
+		"password_wo":      data.PasswordWo,
+		"old_password_wo":  data.OldPasswordWo,
+		"must_change":      data.MustChange,
+		"check_policy":     data.CheckPolicy,
+		"check_expiration": data.CheckExpiration,
+	} {
+		if entraid && !value.IsNull() {
+			logging.AddAttributeError(ctx, path.Root(name), "Invalid attribute configuration",
+				fmt.Sprintf("%s is only supported when authentication equals `SQL`", name))
+		}
+	}
+
+	if data.MustChange.ValueBool() {
+		if !data.OldPasswordWo.IsNull() {
+			logging.AddAttributeError(ctx, path.Root("must_change"), "Invalid attribute configuration",
+				"must_change cannot be combined with old_password_wo")
+		}
+		if (!data.CheckExpiration.IsUnknown() && !data.CheckExpiration.ValueBool()) || data.CheckPolicy.Equal(types.BoolValue(false)) {
+			logging.AddAttributeError(ctx, path.Root("must_change"), "Invalid attribute configuration",
+				"must_change requires check_policy and check_expiration")
+		}
+	}
+
+	if data.CheckExpiration.ValueBool() && data.CheckPolicy.Equal(types.BoolValue(false)) {
+		logging.AddAttributeError(ctx, path.Root("check_expiration"), "Invalid attribute configuration",
+			"check_expiration requires check_policy")
+	}
+}
+
+func (r SQLLoginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// no modification required on delete
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var passwordWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the password isn't stored when a write-only password is used
+	if !passwordWo.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password"), types.StringNull())...)
+	}
+}
+
+func setState(state *SQLLoginResourceModel, login sql.Login) {
+	state.Id = types.StringValue(login.Id)
+	state.Name = types.StringValue(login.Name)
+	// sql server returns the sid in uppercase, keep the configured casing
+	if !strings.EqualFold(state.Sid.ValueString(), login.Sid) {
+		state.Sid = types.StringValue(login.Sid)
+	}
+	state.Authentication = types.StringValue(login.Authentication)
+	// the identifier read back is derived from the sid, which is the application id for service principals
+	// configured by object id, so a configured identifier is kept
+	if state.EntraIDIdentifier.IsNull() || state.EntraIDIdentifier.IsUnknown() || login.EntraIDIdentifier == "" {
+		state.EntraIDIdentifier = optionalString(login.EntraIDIdentifier)
+	}
+	state.Enabled = types.BoolValue(login.Enabled)
+	state.DefaultDatabase = optionalString(login.DefaultDatabase)
+	state.DefaultLanguage = optionalString(login.DefaultLanguage)
+
+	// password policies only apply to SQL logins
+	state.CheckPolicy = types.BoolNull()
+	if login.CheckPolicy.Valid {
+		state.CheckPolicy = types.BoolValue(login.CheckPolicy.Bool)
+	}
+	state.CheckExpiration = types.BoolNull()
+	if login.CheckExpiration.Valid {
+		state.CheckExpiration = types.BoolValue(login.CheckExpiration.Bool)
+	}
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// Unknown values are left to the server defaults.
+func knownString(value types.String) string {
+	if value.IsUnknown() {
+		return ""
+	}
+	return value.ValueString()
+}
+
+// The password is taken from password_wo when specified. Write-only values are only
+// available in the config.
+func getPassword(plan SQLLoginResourceModel, config SQLLoginResourceModel) string {
+	if !config.PasswordWo.IsNull() {
+		return config.PasswordWo.ValueString()
+	}
+	return knownString(plan.Password)
+}
+
 func (r *SQLLoginResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
 
-	var plan SQLLoginResourceModel
+	var plan, config SQLLoginResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -100,12 +328,29 @@ func (r *SQLLoginResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	password := plan.Password.ValueString()
-	if password == "" {
-		password = sql.GeneratePassword(20, 3, 4, 5)
+	authentication := plan.Authentication.ValueString()
+
+	password := getPassword(plan, config)
+	if password == "" && authentication == "SQL" {
+		password = sql.GeneratePassword(ctx, sql.DefaultPasswordPolicy)
+		if logging.HasError(ctx) {
+			return
+		}
 	}
 
-	login := sql.CreateLogin(ctx, connection, name, password)
+	login := sql.CreateLogin(ctx, connection, sql.Login{
+		Name:              name,
+		Password:          password,
+		Sid:               knownString(plan.Sid),
+		Authentication:    authentication,
+		EntraIDIdentifier: knownString(plan.EntraIDIdentifier),
+		Enabled:           plan.Enabled.ValueBool(),
+		DefaultDatabase:   knownString(plan.DefaultDatabase),
+		DefaultLanguage:   knownString(plan.DefaultLanguage),
+		CheckPolicy:       dbsql.NullBool{Bool: plan.CheckPolicy.ValueBool(), Valid: !plan.CheckPolicy.IsNull() && !plan.CheckPolicy.IsUnknown()},
+		CheckExpiration:   dbsql.NullBool{Bool: plan.CheckExpiration.ValueBool(), Valid: !plan.CheckExpiration.IsNull() && !plan.CheckExpiration.IsUnknown()},
+		MustChange:        plan.MustChange.ValueBool(),
+	})
 
 	if logging.HasError(ctx) {
 		if login.Id != "" {
@@ -117,9 +362,11 @@ func (r *SQLLoginResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	plan.Password = types.StringValue(login.Password)
-	plan.Sid = types.StringValue(login.Sid)
-	plan.Id = types.StringValue(login.Id)
+	setState(&plan, login)
+	plan.Password = types.StringNull()
+	if authentication == "SQL" && config.PasswordWo.IsNull() {
+		plan.Password = types.StringValue(login.Password)
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -170,9 +417,7 @@ func (r *SQLLoginResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	state.Sid = types.StringValue(login.Sid)
-	state.Name = types.StringValue(login.Name)
-	state.Id = types.StringValue(login.Id)
+	setState(&state, login)
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -202,7 +447,83 @@ func (r *SQLLoginResource) Configure(_ context.Context, req resource.ConfigureRe
 }
 
 func (r *SQLLoginResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Never triggered as a replace is always required
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan, state, config SQLLoginResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, plan.Server.ValueString(), true, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	// rename first, as all following statements refer to the new name
+	if !plan.Name.Equal(state.Name) {
+		sql.UpdateLoginName(ctx, connection, state.Name.ValueString(), name)
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+
+	// switching from password to password_wo rotates the password as well, as the state still holds the previous password
+	writeOnlyChanged := !config.PasswordWo.IsNull() && (!plan.PasswordWoVersion.Equal(state.PasswordWoVersion) || !state.Password.IsNull())
+	passwordChanged := config.PasswordWo.IsNull() && !plan.Password.IsNull() && !plan.Password.Equal(state.Password)
+
+	if writeOnlyChanged || passwordChanged {
+		sql.UpdateLoginPassword(ctx, connection, name, getPassword(plan, config), config.OldPasswordWo.ValueString(), plan.MustChange.ValueBool())
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+
+	// only changed options are altered, unknown values are kept
+	var options sql.Login
+	if !plan.DefaultDatabase.IsUnknown() && !plan.DefaultDatabase.Equal(state.DefaultDatabase) {
+		options.DefaultDatabase = plan.DefaultDatabase.ValueString()
+	}
+	if !plan.DefaultLanguage.IsUnknown() && !plan.DefaultLanguage.Equal(state.DefaultLanguage) {
+		options.DefaultLanguage = plan.DefaultLanguage.ValueString()
+	}
+	if !plan.CheckPolicy.IsUnknown() && !plan.CheckPolicy.IsNull() && !plan.CheckPolicy.Equal(state.CheckPolicy) {
+		options.CheckPolicy = dbsql.NullBool{Bool: plan.CheckPolicy.ValueBool(), Valid: true}
+	}
+	if !plan.CheckExpiration.IsUnknown() && !plan.CheckExpiration.IsNull() && !plan.CheckExpiration.Equal(state.CheckExpiration) {
+		options.CheckExpiration = dbsql.NullBool{Bool: plan.CheckExpiration.ValueBool(), Valid: true}
+	}
+
+	sql.UpdateLoginOptions(ctx, connection, name, options)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if !plan.Enabled.Equal(state.Enabled) {
+		sql.UpdateLoginEnabled(ctx, connection, name, plan.Enabled.ValueBool())
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+
+	login := sql.GetLoginFromSid(ctx, connection, state.Sid.ValueString())
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if login.Id == "" {
+		logging.AddError(ctx, "Login not found", fmt.Sprintf("Login %s doesn't exist after update", name))
+		return
+	}
+
+	setState(&plan, login)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *SQLLoginResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -251,11 +572,10 @@ func (r *SQLLoginResource) ImportState(ctx context.Context, req resource.ImportS
 	}
 
 	state := SQLLoginResourceModel{
-		Id:     types.StringValue(login.Id),
-		Server: types.StringValue(login.Connection),
-		Name:   types.StringValue(login.Name),
-		Sid:    types.StringValue(login.Sid),
+		Server:     types.StringValue(login.Connection),
+		MustChange: types.BoolValue(false),
 	}
+	setState(&state, login)
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
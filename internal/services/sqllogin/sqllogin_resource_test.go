@@ -98,6 +98,45 @@ func TestAccSynapseServerCreateLogin(t *testing.T) {
 	}
 }
 
+func TestAccUpdateLogin(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := SQLLoginResource{}
+
+	var sid string
+
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:                   r.with_options(data.SQLServer_connection, "tftest_"+data.RandomString, "password12345!$", true),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuresql_login.test", "enabled", "true"),
+					resource.TestCheckResourceAttrWith("azuresql_login.test", "sid", func(value string) error {
+						sid = value
+						return nil
+					}),
+				),
+			},
+			{
+				// rename, rotate the password and disable the login in place
+				Config:                   r.with_options(data.SQLServer_connection, "tftest_renamed_"+data.RandomString, "password54321!$", false),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuresql_login.test", "name", "tftest_renamed_"+data.RandomString),
+					resource.TestCheckResourceAttr("azuresql_login.test", "enabled", "false"),
+					resource.TestCheckResourceAttrWith("azuresql_login.test", "sid", func(value string) error {
+						if value != sid {
+							return fmt.Errorf("sid changed from %s to %s", sid, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func (r SQLLoginResource) basic(connection string, name string) string {
 	template := r.template()
 
@@ -133,3 +172,19 @@ func (r SQLLoginResource) template() string {
 		}
 	`)
 }
+
+func (r SQLLoginResource) with_options(connection string, name string, password string, enabled bool) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_login" "test" {
+			server   = "%[2]s"
+			name     = "%[3]s"
+			password = "%[4]s"
+			enabled  = %[5]t
+		}
+		`, template, connection, name, password, enabled)
+}
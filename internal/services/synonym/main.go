@@ -0,0 +1,14 @@
+package synonym
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SynonymResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Database   types.String `tfsdk:"database"`
+	ObjectId   types.Int64  `tfsdk:"object_id"`
+	Name       types.String `tfsdk:"name"`
+	Schema     types.String `tfsdk:"schema"`
+	BaseObject types.String `tfsdk:"base_object"`
+}
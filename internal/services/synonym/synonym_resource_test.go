@@ -0,0 +1,74 @@
+package synonym_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type SynonymResource struct{}
+
+func TestAccCreateSynonymBasic(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := SynonymResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+
+		acceptance.ExecuteSQL(connection, fmt.Sprintf("create table dbo.tftable_%s (col1 int)", data.RandomString))
+		defer acceptance.ExecuteSQL(connection, fmt.Sprintf("DROP table dbo.tftable_%s", data.RandomString))
+
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.basic(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_synonym.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+					// sys.synonyms returns the base object with quoted parts
+					ImportStateVerifyIgnore: []string{"base_object"},
+				},
+			},
+		})
+	}
+}
+
+func (r SynonymResource) basic(connection string, name string) string {
+	template := r.template()
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		data "azuresql_schema" "dbo" {
+			database 	= "%[2]s"
+			name 		= "dbo"
+		}
+
+		resource "azuresql_synonym" "test" {
+			database 	= "%[2]s"
+			name        = "tfsynonym_%[3]s"
+			schema		= data.azuresql_schema.dbo.id
+			base_object	= "dbo.tftable_%[3]s"
+		}
+		`, template, connection, name)
+}
+
+func (r SynonymResource) template() string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+	`)
+}
@@ -0,0 +1,14 @@
+package table_rows
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TableRowsResourceModel struct {
+	Id              types.String   `tfsdk:"id"`
+	Database        types.String   `tfsdk:"database"`
+	Table           types.String   `tfsdk:"table"`
+	KeyColumns      []types.String `tfsdk:"key_columns"`
+	Rows            types.List     `tfsdk:"rows"`
+	DeleteUnmanaged types.Bool     `tfsdk:"delete_unmanaged"`
+}
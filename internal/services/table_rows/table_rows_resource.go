@@ -0,0 +1,400 @@
+package table_rows
+
+import (
+	"context"
+	dbsql "database/sql"
+	"fmt"
+	"strings"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &TableRowsResource{}
+	_ resource.ResourceWithConfigure   = &TableRowsResource{}
+	_ resource.ResourceWithImportState = &TableRowsResource{}
+)
+
+var rowType = types.MapType{ElemType: types.StringType}
+
+func NewTableRowsResource() resource.Resource {
+	return &TableRowsResource{}
+}
+
+type TableRowsResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *TableRowsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_table_rows"
+}
+
+func (r *TableRowsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rows of reference data in a table.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database containing the table.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the table in which the rows are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_columns": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Columns identifying a row.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"rows": schema.ListAttribute{
+				Required:    true,
+				ElementType: rowType,
+				Description: "Rows managed by the resource, as a map of column name to value.",
+			},
+			"delete_unmanaged": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Delete all rows in the table which aren't specified in rows.",
+			},
+		},
+	}
+}
+
+func getKeyColumns(model TableRowsResourceModel) (keyColumns []string) {
+	for _, column := range model.KeyColumns {
+		keyColumns = append(keyColumns, column.ValueString())
+	}
+	return keyColumns
+}
+
+func getRows(ctx context.Context, list types.List) (rows []sql.TableRow) {
+	var values []map[string]types.String
+	logging.AppendDiagnostics(ctx, list.ElementsAs(ctx, &values, false)...)
+
+	for _, value := range values {
+		row := sql.TableRow{}
+		for column, v := range value {
+			// an empty string is a valid value, only null is mapped to NULL
+			row[column] = dbsql.NullString{String: v.ValueString(), Valid: !v.IsNull()}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func rowsValue(ctx context.Context, rows []sql.TableRow) types.List {
+	values := []map[string]types.String{}
+
+	for _, row := range rows {
+		value := map[string]types.String{}
+		for column, v := range row {
+			if v.Valid {
+				value[column] = types.StringValue(v.String)
+			} else {
+				value[column] = types.StringNull()
+			}
+		}
+		values = append(values, value)
+	}
+
+	list, diags := types.ListValueFrom(ctx, rowType, values)
+	logging.AppendDiagnostics(ctx, diags...)
+	return list
+}
+
+// Return the rows of old whose key isn't used in new.
+func removedRows(keyColumns []string, old []sql.TableRow, new []sql.TableRow) (removed []sql.TableRow) {
+	key := func(row sql.TableRow) string {
+		var values []string
+		for _, column := range keyColumns {
+			values = append(values, row[column].String)
+		}
+		return strings.Join(values, "\x00")
+	}
+
+	keys := map[string]bool{}
+	for _, row := range new {
+		keys[key(row)] = true
+	}
+
+	for _, row := range old {
+		if !keys[key(row)] {
+			removed = append(removed, row)
+		}
+	}
+	return removed
+}
+
+func (r *TableRowsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan TableRowsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider != "sqlserver" {
+		logging.AddError(ctx, "Invalid config",
+			"`azuresql_table_rows` resource is only supported on SQL databases.")
+		return
+	}
+
+	tableRows := sql.GetTableRows(ctx, connection, plan.Table.ValueString(), getKeyColumns(plan))
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	sql.MergeTableRows(ctx, connection, tableRows, getRows(ctx, plan.Rows), plan.DeleteUnmanaged.ValueBool())
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	plan.Id = types.StringValue(tableRows.Id)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TableRowsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state TableRowsResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	table := sql.GetTableFromId(ctx, connection, state.Table.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if table.Name == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tableRows := sql.GetTableRowsFromId(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	// rows are unknown right after an import, they are merged on the next apply
+	if !state.Rows.IsNull() {
+		rows := sql.ReadTableRows(ctx, connection, tableRows, getRows(ctx, state.Rows), state.DeleteUnmanaged.ValueBool())
+
+		if logging.HasError(ctx) {
+			return
+		}
+
+		state.Rows = rowsValue(ctx, rows)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TableRowsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *TableRowsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state, plan TableRowsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, state.Database.ValueString(), false, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	tableRows := sql.GetTableRowsFromId(ctx, connection, state.Id.ValueString())
+	if logging.HasError(ctx) {
+		return
+	}
+
+	rows := getRows(ctx, plan.Rows)
+
+	// rows removed from the configuration are no longer managed and have to be deleted
+	if !state.Rows.IsNull() {
+		sql.DeleteTableRows(ctx, connection, tableRows, removedRows(tableRows.KeyColumns, getRows(ctx, state.Rows), rows))
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+
+	sql.MergeTableRows(ctx, connection, tableRows, rows, plan.DeleteUnmanaged.ValueBool())
+	if logging.HasError(ctx) {
+		return
+	}
+
+	plan.Id = state.Id
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TableRowsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state TableRowsResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	table := sql.GetTableFromId(ctx, connection, state.Table.ValueString(), false)
+	if logging.HasError(ctx) || table.Name == "" || state.Rows.IsNull() {
+		return
+	}
+
+	tableRows := sql.GetTableRowsFromId(ctx, connection, state.Id.ValueString())
+	if logging.HasError(ctx) {
+		return
+	}
+
+	sql.DeleteTableRows(ctx, connection, tableRows, getRows(ctx, state.Rows))
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Deleting rows failed", fmt.Sprintf("Deleting rows from table %s failed", state.Table.ValueString()))
+	}
+}
+
+func (r *TableRowsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing table rows %s", req.ID))
+
+	tableRows := sql.ParseTableRowsId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, tableRows.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	tableRows = sql.GetTableRowsFromId(ctx, connection, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	var keyColumns []types.String
+	for _, column := range tableRows.KeyColumns {
+		keyColumns = append(keyColumns, types.StringValue(column))
+	}
+
+	state := TableRowsResourceModel{
+		Id:              types.StringValue(tableRows.Id),
+		Database:        types.StringValue(tableRows.Connection),
+		Table:           types.StringValue(tableRows.Table),
+		KeyColumns:      keyColumns,
+		Rows:            types.ListNull(rowType),
+		DeleteUnmanaged: types.BoolValue(false),
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
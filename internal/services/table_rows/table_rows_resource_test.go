@@ -0,0 +1,104 @@
+package table_rows_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type TableRowsResource struct{}
+
+func TestAccCreateTableRowsBasic(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := TableRowsResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+
+		acceptance.ExecuteSQL(connection, fmt.Sprintf("create table dbo.tftable_%s (code varchar(2) primary key, name nvarchar(100) null, active bit not null)", data.RandomString))
+		defer acceptance.ExecuteSQL(connection, fmt.Sprintf("DROP table dbo.tftable_%s", data.RandomString))
+
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.basic(connection, data.RandomString, "Belgium"),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.basic(connection, data.RandomString, "België"),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					PreConfig: func() {
+						acceptance.ExecuteSQL(connection, fmt.Sprintf("insert into dbo.tftable_%s values ('NL', 'Netherlands', 1)", data.RandomString))
+					},
+					Config:                   r.delete_unmanaged(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+			},
+		})
+	}
+}
+
+func (r TableRowsResource) basic(connection string, name string, belgium string) string {
+	template := r.template(connection, name)
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_table_rows" "test" {
+			database 		= "%[2]s"
+			table			= data.azuresql_table.test.id
+			key_columns		= ["code"]
+			rows			= [
+				{ code = "BE", name = "%[3]s", active = "1" },
+				{ code = "FR", name = null, active = "0" },
+			]
+		}
+		`, template, connection, belgium)
+}
+
+func (r TableRowsResource) delete_unmanaged(connection string, name string) string {
+	template := r.template(connection, name)
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_table_rows" "test" {
+			database 			= "%[2]s"
+			table				= data.azuresql_table.test.id
+			key_columns			= ["code"]
+			delete_unmanaged	= true
+			rows				= [
+				{ code = "BE", name = "Belgium", active = "1" },
+			]
+		}
+		`, template, connection)
+}
+
+func (r TableRowsResource) template(connection string, name string) string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+
+		data "azuresql_schema" "dbo" {
+			database 	= "%[1]s"
+			name 		= "dbo"
+		}
+
+		data "azuresql_table" "test" {
+			database  	= "%[1]s"
+			schema		= data.azuresql_schema.dbo.id
+			name    	= "tftable_%[2]s"
+		}
+	`, connection, name)
+}
@@ -0,0 +1,17 @@
+package trigger
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TriggerResourceModel struct {
+	Id         types.String   `tfsdk:"id"`
+	Database   types.String   `tfsdk:"database"`
+	ObjectId   types.Int64    `tfsdk:"object_id"`
+	Name       types.String   `tfsdk:"name"`
+	Table      types.String   `tfsdk:"table"`
+	Type       types.String   `tfsdk:"type"`
+	Events     []types.String `tfsdk:"events"`
+	Definition types.String   `tfsdk:"definition"`
+	Enabled    types.Bool     `tfsdk:"enabled"`
+}
@@ -0,0 +1,382 @@
+package trigger
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &TriggerResource{}
+	_ resource.ResourceWithConfigure      = &TriggerResource{}
+	_ resource.ResourceWithImportState    = &TriggerResource{}
+	_ resource.ResourceWithValidateConfig = &TriggerResource{}
+)
+
+func NewTriggerResource() resource.Resource {
+	return &TriggerResource{}
+}
+
+type TriggerResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *TriggerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger"
+}
+
+func (r *TriggerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DML trigger on a table or DDL trigger on the database.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database where the trigger should be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the trigger",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the trigger object in the database",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"table": schema.StringAttribute{
+				Optional:    true,
+				Description: "Id of the table on which the DML trigger is created. When not set, a DDL trigger is created on the database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("after"),
+				Description: "When the trigger fires: `after` or `instead of` the triggering statement. DDL triggers only support `after`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"after", "instead of"}...),
+				},
+			},
+			"events": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Statements which fire the trigger, e.g. `insert` or `DDL_DATABASE_LEVEL_EVENTS`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"definition": schema.StringAttribute{
+				Required:    true,
+				Description: "Definition of the trigger.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "If false, the trigger is disabled.",
+			},
+		},
+	}
+}
+
+func (r TriggerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var data TriggerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Table.IsNull() && data.Type.ValueString() == "instead of" {
+		logging.AddAttributeError(ctx, path.Root("type"), "Invalid attribute configuration",
+			"`instead of` triggers require a table, DDL triggers only support `after`")
+		return
+	}
+}
+
+func getTrigger(model TriggerResourceModel) sql.Trigger {
+	trigger := sql.Trigger{
+		Type:       model.Type.ValueString(),
+		Definition: model.Definition.ValueString(),
+		Enabled:    model.Enabled.ValueBool(),
+	}
+
+	for _, event := range model.Events {
+		trigger.Events = append(trigger.Events, event.ValueString())
+	}
+
+	return trigger
+}
+
+func setState(model *TriggerResourceModel, trigger sql.Trigger) {
+	model.Id = types.StringValue(trigger.Id)
+	model.ObjectId = types.Int64Value(trigger.ObjectId)
+	model.Name = types.StringValue(trigger.Name)
+	model.Type = types.StringValue(trigger.Type)
+	model.Enabled = types.BoolValue(trigger.Enabled)
+
+	if trigger.Table == "" {
+		model.Table = types.StringNull()
+	} else {
+		model.Table = types.StringValue(trigger.Table)
+	}
+
+	var events []string
+	for _, event := range model.Events {
+		events = append(events, event.ValueString())
+	}
+
+	if !sql.IsTriggerEventsEquivalent(events, trigger.Events) {
+		model.Events = nil
+		for _, event := range trigger.Events {
+			model.Events = append(model.Events, types.StringValue(event))
+		}
+	}
+
+	if !sql.IsTriggerDefinitionEquivalent(model.Definition.ValueString(), trigger.Definition) {
+		model.Definition = types.StringValue(trigger.Definition)
+	}
+}
+
+func (r *TriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan TriggerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider != "sqlserver" {
+		logging.AddError(ctx, "Invalid config",
+			"`azuresql_trigger` resource is only supported on SQL databases.")
+		return
+	}
+
+	trigger := sql.CreateTrigger(ctx, connection, name, plan.Table.ValueString(), getTrigger(plan))
+
+	if logging.HasError(ctx) {
+		if trigger.Id != "" {
+			logging.AddError(
+				ctx,
+				"Trigger already exists",
+				fmt.Sprintf("You can import this resource using `terraform import azuresql_trigger.<name> %s", trigger.Id))
+		}
+		return
+	}
+
+	setState(&plan, trigger)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state TriggerResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	trigger := sql.GetTriggerFromId(ctx, connection, state.Id.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if trigger.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setState(&state, trigger)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TriggerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *TriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state, plan TriggerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, state.Database.ValueString(), false, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	trigger := getTrigger(plan)
+	current := getTrigger(state)
+
+	alter := trigger.Type != current.Type ||
+		!sql.IsTriggerEventsEquivalent(trigger.Events, current.Events) ||
+		!sql.IsTriggerDefinitionEquivalent(trigger.Definition, current.Definition)
+
+	sql.UpdateTrigger(ctx, connection, state.Id.ValueString(), trigger, alter)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	trigger = sql.GetTriggerFromId(ctx, connection, state.Id.ValueString(), true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	setState(&plan, trigger)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state TriggerResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	sql.DropTrigger(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Dropping trigger failed", fmt.Sprintf("Dropping trigger %s failed", state.Name.ValueString()))
+	}
+}
+
+func (r *TriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing trigger %s", req.ID))
+
+	trigger := sql.ParseTriggerId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, trigger.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	trigger = sql.GetTriggerFromId(ctx, connection, req.ID, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := TriggerResourceModel{
+		Database:   types.StringValue(trigger.Connection),
+		Definition: types.StringValue(trigger.Definition),
+	}
+	setState(&state, trigger)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,134 @@
+package trigger_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type TriggerResource struct{}
+
+func TestAccCreateTriggerDML(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := TriggerResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+
+		acceptance.ExecuteSQL(connection, fmt.Sprintf("create table dbo.tftable_%s (id int primary key, modified datetime2 null)", data.RandomString))
+		defer acceptance.ExecuteSQL(connection, fmt.Sprintf("DROP table dbo.tftable_%s", data.RandomString))
+
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.dml(connection, data.RandomString, `["insert"]`, true),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.dml(connection, data.RandomString, `["insert", "update"]`, false),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.dml(connection, data.RandomString, `["insert", "update"]`, false),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_trigger.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func TestAccCreateTriggerDDL(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := TriggerResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.ddl(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.ddl(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_trigger.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func (r TriggerResource) dml(connection string, name string, events string, enabled bool) string {
+	template := r.template(connection)
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		data "azuresql_table" "test" {
+			database  	= "%[2]s"
+			schema		= data.azuresql_schema.dbo.id
+			name    	= "tftable_%[3]s"
+		}
+
+		resource "azuresql_trigger" "test" {
+			database 	= "%[2]s"
+			name        = "tftrigger_%[3]s"
+			table		= data.azuresql_table.test.id
+			events		= %[4]s
+			enabled		= %[5]t
+			definition	= <<-EOT
+				update t set modified = sysutcdatetime()
+				from dbo.tftable_%[3]s t
+				inner join inserted i on i.id = t.id
+			EOT
+		}
+		`, template, connection, name, events, enabled)
+}
+
+func (r TriggerResource) ddl(connection string, name string) string {
+	template := r.template(connection)
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_trigger" "test" {
+			database 	= "%[2]s"
+			name        = "tftrigger_%[3]s"
+			events		= ["DDL_DATABASE_LEVEL_EVENTS"]
+			definition	= "print 'ddl event'"
+		}
+		`, template, connection, name)
+}
+
+func (r TriggerResource) template(connection string) string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+
+		data "azuresql_schema" "dbo" {
+			database 	= "%[1]s"
+			name 		= "dbo"
+		}
+	`, connection)
+}
@@ -27,6 +27,11 @@ type UserResourceModel struct {
 	Type              types.String `tfsdk:"type"`
 	Login             types.String `tfsdk:"login"`
 	EntraIDIdentifier types.String `tfsdk:"entraid_identifier"`
+	EntraIDType       types.String `tfsdk:"entraid_type"`
+	Certificate       types.String `tfsdk:"certificate"`
+	AsymmetricKey     types.String `tfsdk:"asymmetric_key"`
 	DefaultSchema     types.String `tfsdk:"default_schema"`
 	Sid               types.String `tfsdk:"sid"`
+	OnDestroy         types.String `tfsdk:"on_destroy"`
+	ReassignOwnedTo   types.String `tfsdk:"reassign_owned_to"`
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -66,6 +67,33 @@ func (m replaceIfSetOrChanged) PlanModifyString(
 	}
 }
 
+// A user mapped to a SQL login can be migrated to a contained user with a password in SQL Server and
+// Managed Instance. SQL can't change the sid of a SQL user to an Entra ID identity, so that migration
+// fails the plan instead of silently dropping the permissions with the user. Any other change of the
+// authentication requires a new user.
+func requiresReplaceAuthentication(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	state := req.StateValue.ValueString()
+	plan := req.PlanValue.ValueString()
+
+	if (state == "SQLLogin" || state == "DBSQLLogin") && plan == "AzureAD" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Unsupported authentication change",
+			fmt.Sprintf("Migrating a %s user to an AzureAD user isn't supported, as SQL can't change the sid of a user to an Entra ID identity. "+
+				"Destroy the user first, e.g. by removing it from the configuration, and create it again as an AzureAD user, which drops its permissions and role memberships.", state))
+		return
+	}
+
+	resp.RequiresReplace = !(state == "SQLLogin" && plan == "DBSQLLogin")
+}
+
+// Users and applications are both created with type E and the same sid,
+// so only changes from or to a group require a new user.
+func requiresReplaceEntraIDType(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	individual := func(value types.String) bool {
+		return value.ValueString() == "user" || value.ValueString() == "application"
+	}
+	resp.RequiresReplace = !(individual(req.StateValue) && individual(req.PlanValue))
+}
+
 func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: fmt.Sprintf("SQL database or server user. %s", docu.Supported(true, true, true, true)),
@@ -95,18 +123,12 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "Name of the user",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Description: "Name of the user. Renaming the user keeps its principal id, permissions and role memberships.",
 			},
 			"password": schema.StringAttribute{
 				Optional:    true,
-				Description: "Password for the new user, if creating a DB-scoped user with a password.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-				Sensitive: true,
+				Description: "Password for the new user, if creating a DB-scoped user with a password. Changed in place.",
+				Sensitive:   true,
 			},
 			"principal_id": schema.Int64Attribute{
 				Computed:    true,
@@ -114,12 +136,14 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"authentication": schema.StringAttribute{
 				Required:    true,
-				Description: "The user authentication mode. Possible values are `AzureAD`, `SQLLogin`, `DBSQLLogin`, or `WithoutLogin`.",
+				Description: "The user authentication mode. Possible values are `AzureAD`, `SQLLogin`, `DBSQLLogin`, `WithoutLogin`, `Certificate` or `AsymmetricKey`.",
 				Validators: []validator.String{
-					stringvalidator.OneOf([]string{"AzureAD", "SQLLogin", "DBSQLLogin", "WithoutLogin"}...),
+					stringvalidator.OneOf([]string{"AzureAD", "SQLLogin", "DBSQLLogin", "WithoutLogin", "Certificate", "AsymmetricKey"}...),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(requiresReplaceAuthentication,
+						"Changing the authentication forces replacement, except migrating a `SQLLogin` user to `DBSQLLogin`. Migrating a `SQLLogin` or `DBSQLLogin` user to `AzureAD` isn't supported.",
+						"Changing the authentication forces replacement, except migrating a `SQLLogin` user to `DBSQLLogin`. Migrating a `SQLLogin` or `DBSQLLogin` user to `AzureAD` isn't supported."),
 				},
 			},
 			"type": schema.StringAttribute{
@@ -128,10 +152,7 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"login": schema.StringAttribute{
 				Optional:    true,
-				Description: "Id of the server where the user should be created. database or server should be specified.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Description: "Id of the azuresql_login the user is mapped to. Required when authentication equals `SQLLogin`. Changing the login remaps the user in place.",
 			},
 			"entraid_identifier": schema.StringAttribute{
 				Optional: true,
@@ -141,6 +162,35 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					replaceIfSetOrChanged{},
 				},
 			},
+			"entraid_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Type of the Entra ID identity of `entraid_identifier`. Possible values are `user`, `group` and `application`. Defaults to `user`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "group", "application"),
+					stringvalidator.AlsoRequires(path.MatchRoot("entraid_identifier")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(requiresReplaceEntraIDType,
+						"Changing the Entra ID type forces replacement, except between `user` and `application`.",
+						"Changing the Entra ID type forces replacement, except between `user` and `application`."),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "Id of the azuresql_certificate the user is created from. Required when authentication equals `Certificate`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"asymmetric_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Id of the azuresql_asymmetric_key the user is created from. Required when authentication equals `AsymmetricKey`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"default_schema": schema.StringAttribute{
 				Optional:    true,
 				Description: "ID of the azuresql_schema used as the user's default schema. Defaults to `dbo` when not set.",
@@ -148,6 +198,19 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"sid": schema.StringAttribute{
 				Computed: true,
 			},
+			"on_destroy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("fail"),
+				Description: "How objects owned by the user are handled when it is dropped. Possible values are `fail` and `reassign_owned_to`. Defaults to `fail`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("fail", "reassign_owned_to"),
+				},
+			},
+			"reassign_owned_to": schema.StringAttribute{
+				Optional:    true,
+				Description: "Id of the user or role receiving the schemas, objects, types and roles owned by the user when it is dropped. Required when on_destroy equals `reassign_owned_to`.",
+			},
 		},
 	}
 }
@@ -191,10 +254,27 @@ func (r UserResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 		return
 	}
 
+	if !data.Authentication.IsUnknown() && data.Certificate.IsNull() != (data.Authentication.ValueString() != "Certificate") {
+		logging.AddAttributeError(ctx, path.Root("certificate"), "Invalid attribute configuration",
+			"certificate is required and only allowed when authentication equals `Certificate`")
+		return
+	}
+
+	if !data.Authentication.IsUnknown() && data.AsymmetricKey.IsNull() != (data.Authentication.ValueString() != "AsymmetricKey") {
+		logging.AddAttributeError(ctx, path.Root("asymmetric_key"), "Invalid attribute configuration",
+			"asymmetric_key is required and only allowed when authentication equals `AsymmetricKey`")
+		return
+	}
+
 	if !data.DefaultSchema.IsNull() && data.Database.IsNull() {
 		logging.AddAttributeError(ctx, path.Root("default_schema"), "Invalid attribute configuration",
 			"default_schema requires a database user")
 	}
+
+	if !data.OnDestroy.IsUnknown() && !data.ReassignOwnedTo.IsUnknown() && data.ReassignOwnedTo.IsNull() == (data.OnDestroy.ValueString() == "reassign_owned_to") {
+		logging.AddAttributeError(ctx, path.Root("reassign_owned_to"), "Invalid attribute configuration",
+			"reassign_owned_to is required and only allowed when on_destroy equals `reassign_owned_to`")
+	}
 }
 
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -248,7 +328,17 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	user := sql.CreateUser(ctx, connection, name, password, authentication, login, entraid_identifier)
+	if (authentication == "Certificate" || authentication == "AsymmetricKey") && connection.IsServerConnection {
+		logging.AddError(ctx, "Invalid config", fmt.Sprintf("Users with `Authentication=%s` can only be created in a database.", authentication))
+		return
+	}
+
+	key := plan.Certificate.ValueString()
+	if authentication == "AsymmetricKey" {
+		key = plan.AsymmetricKey.ValueString()
+	}
+
+	user := sql.CreateUser(ctx, connection, name, password, authentication, login, entraid_identifier, plan.EntraIDType.ValueString(), key)
 
 	if logging.HasError(ctx) {
 		if user.Id != "" {
@@ -285,6 +375,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if plan.EntraIDIdentifier.IsUnknown() {
 		plan.EntraIDIdentifier = types.StringNull()
 	}
+	plan.EntraIDType = entraIDTypeState(user.Type, plan.EntraIDType)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -352,6 +443,8 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	state.Id = types.StringValue(user.Id)
 
+	state.EntraIDType = entraIDTypeState(user.Type, state.EntraIDType)
+
 	if user.Authentication == "AzureAD" && connection.Provider == "sqlserver" {
 		state.EntraIDIdentifier = types.StringValue(sql.GetEntraIDIdentifierFromPrincipalId(ctx, connection, user.PrincipalId))
 		if logging.HasError(ctx) {
@@ -396,16 +489,63 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	connection := r.ConnectionCache.Connect(ctx, state.Database.ValueString(), false, true)
+	// users can be created on a server connection, like in Create and Read
+	connection := r.ConnectionCache.Connect_server_or_database(ctx, state.Server.ValueString(), state.Database.ValueString(), true)
 	if logging.HasError(ctx) {
 		return
 	}
 
+	if !state.Name.Equal(plan.Name) {
+		sql.UpdateUserName(ctx, connection, state.PrincipalId.ValueInt64(), plan.Name.ValueString())
+		if logging.HasError(ctx) {
+			return
+		}
+		state.Name = plan.Name
+	}
+
+	name := plan.Name.ValueString()
+	authentication := plan.Authentication.ValueString()
+
+	if !state.Authentication.Equal(plan.Authentication) {
+		if connection.Provider != "sqlserver" {
+			logging.AddError(ctx, "Invalid config", fmt.Sprintf("Migrating a user to `Authentication=%s` is only supported in SQL Server.", authentication))
+			return
+		}
+
+		// the contained user gets the password of the login, which is replaced by the configured password below
+		sql.MigrateUserToContained(ctx, connection, name)
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+
+	if authentication == "DBSQLLogin" && (!state.Password.Equal(plan.Password) || !state.Authentication.Equal(plan.Authentication)) {
+		sql.UpdateUserPassword(ctx, connection, name, plan.Password.ValueString())
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+
+	if authentication == "SQLLogin" && !state.Login.Equal(plan.Login) {
+		sql.UpdateUserLogin(ctx, connection, name, plan.Login.ValueString())
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+
+	state.Authentication = plan.Authentication
+	state.Password = plan.Password
+	state.Login = plan.Login
+
 	user := sql.GetUserFromPrincipalId(ctx, connection, state.PrincipalId.ValueInt64())
 	if logging.HasError(ctx) || user.Id == "" {
 		return
 	}
 
+	// remapping or migrating the user changes its sid
+	state.Sid = types.StringValue(user.Sid)
+	state.Type = types.StringValue(user.Type)
+
 	if plan.DefaultSchema.IsNull() {
 		sql.SetUserDefaultSchemaName(ctx, connection, user.Name, "dbo")
 		if logging.HasError(ctx) {
@@ -427,9 +567,30 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 			return
 		}
 	}
+
+	state.EntraIDType = entraIDTypeState(user.Type, plan.EntraIDType)
+	state.OnDestroy = plan.OnDestroy
+	state.ReassignOwnedTo = plan.ReassignOwnedTo
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// Users and applications both have type E, so the configured type is kept for these users.
+// Read and import report `user`, unless the state says `application`.
+func entraIDTypeState(userType string, current types.String) types.String {
+	switch userType {
+	case "AD group":
+		return types.StringValue("group")
+	case "AD user":
+		if current.ValueString() == "application" {
+			return current
+		}
+		return types.StringValue("user")
+	default:
+		return types.StringNull()
+	}
+}
+
 func (r *UserResource) defaultSchemaState(ctx context.Context, connection sql.Connection, name string) types.String {
 	if name == "" || connection.IsServerConnection {
 		return types.StringNull()
@@ -465,7 +626,7 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	sql.DropUser(ctx, connection, state.PrincipalId.ValueInt64())
+	sql.DropUser(ctx, connection, state.PrincipalId.ValueInt64(), state.OnDestroy.ValueString(), state.ReassignOwnedTo.ValueString())
 
 	if logging.HasError(ctx) {
 		resp.Diagnostics.AddError("Dropping user failed", fmt.Sprintf("Dropping user %s failed", state.Name.ValueString()))
@@ -506,8 +667,11 @@ func (r *UserResource) ImportState(ctx context.Context, req resource.ImportState
 		PrincipalId:    types.Int64Value(user.PrincipalId),
 		Authentication: types.StringValue(user.Authentication),
 		Type:           types.StringValue(user.Type),
+		OnDestroy:      types.StringValue("fail"),
 	}
 
+	state.EntraIDType = entraIDTypeState(user.Type, state.EntraIDType)
+
 	if user.Authentication == "AzureAD" && connection.Provider == "sqlserver" {
 		state.EntraIDIdentifier = types.StringValue(sql.GetEntraIDIdentifierFromPrincipalId(ctx, connection, user.PrincipalId))
 
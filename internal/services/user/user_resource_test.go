@@ -293,6 +293,58 @@ func TestAccDBSQLLogin(t *testing.T) {
 	}
 }
 
+func TestAccDBSQLLoginChangePassword(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := UserResource{}
+
+	var principalId string
+
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:                   r.user_with_database_password(data.SQLDatabase_connection, data.RandomString, "Difficultpassword12!abc13!!"),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.TestCheckResourceAttrWith("azuresql_user.test", "principal_id", func(value string) error {
+					principalId = value
+					return nil
+				}),
+			},
+			{
+				Config:                   r.user_with_database_password(data.SQLDatabase_connection, data.RandomString, "Difficultpassword12!abc13!!changed"),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.TestCheckResourceAttrWith("azuresql_user.test", "principal_id", func(value string) error {
+					if value != principalId {
+						return fmt.Errorf("user was replaced, principal id changed from %s to %s", principalId, value)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func TestAccDBSQLLoginMigrateToAzureADUnsupported(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := UserResource{}
+
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:                   r.user_with_database_password(data.SQLDatabase_connection, data.RandomString, "Difficultpassword12!abc13!!"),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+			},
+			{
+				// the user isn't replaced, which would drop its permissions
+				Config:                   r.user_with_database_azuread(data.SQLDatabase_connection, data.RandomString),
+				ExpectError:              regexp.MustCompile("Unsupported authentication change"),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+			},
+		},
+	})
+}
+
 func (r UserResource) basic_server(connection string, username string, authentication string) string {
 	template := r.template()
 
@@ -425,6 +477,33 @@ func (r UserResource) user_with_database_login(connection string, name string) s
 		`, r.template(), connection, name)
 }
 
+func (r UserResource) user_with_database_password(connection string, name string, password string) string {
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_user" "test" {
+			database 		= "%[2]s"
+			name           	= "user_database_login_%[3]s"
+			authentication 	= "DBSQLLogin"
+			password 		= "%[4]s"
+		}
+		`, r.template(), connection, name, password)
+}
+
+func (r UserResource) user_with_database_azuread(connection string, name string) string {
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_user" "test" {
+			database 		= "%[2]s"
+			name           	= "user_database_login_%[3]s"
+			authentication 	= "AzureAD"
+		}
+		`, r.template(), connection, name)
+}
+
 func (r UserResource) database_with_login(server string, database string, random string) string {
 	template := r.template()
 
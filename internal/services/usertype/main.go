@@ -0,0 +1,30 @@
+package usertype
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TypeColumnResourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Nullable types.Bool   `tfsdk:"nullable"`
+}
+
+type TypeIndexResourceModel struct {
+	Name    types.String   `tfsdk:"name"`
+	Columns []types.String `tfsdk:"columns"`
+	Unique  types.Bool     `tfsdk:"unique"`
+}
+
+type TypeResourceModel struct {
+	Id         types.String              `tfsdk:"id"`
+	Database   types.String              `tfsdk:"database"`
+	TypeId     types.Int64               `tfsdk:"type_id"`
+	Name       types.String              `tfsdk:"name"`
+	Schema     types.String              `tfsdk:"schema"`
+	BaseType   types.String              `tfsdk:"base_type"`
+	Nullable   types.Bool                `tfsdk:"nullable"`
+	Columns    []TypeColumnResourceModel `tfsdk:"columns"`
+	PrimaryKey []types.String            `tfsdk:"primary_key"`
+	Indexes    []TypeIndexResourceModel  `tfsdk:"indexes"`
+}
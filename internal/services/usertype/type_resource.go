@@ -0,0 +1,512 @@
+package usertype
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-azuresql/internal/logging"
+	"terraform-provider-azuresql/internal/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &TypeResource{}
+	_ resource.ResourceWithConfigure      = &TypeResource{}
+	_ resource.ResourceWithImportState    = &TypeResource{}
+	_ resource.ResourceWithValidateConfig = &TypeResource{}
+)
+
+func NewTypeResource() resource.Resource {
+	return &TypeResource{}
+}
+
+type TypeResource struct {
+	ConnectionCache *sql.ConnectionCache
+}
+
+func (r *TypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_type"
+}
+
+func (r *TypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "User-defined alias type or table type.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for terraform used to import the resource.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Id of the database where the type should be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the type",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the type in the database",
+			},
+			"schema": schema.StringAttribute{
+				Required:    true,
+				Description: "Schema where the type resides.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "System type on which an alias type is based, e.g. `nvarchar(50)`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("columns"),
+					}...),
+				},
+			},
+			"nullable": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "If false, the alias type doesn't accept null values.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"columns": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Columns of a table type.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"type": schema.StringAttribute{
+							Required: true,
+						},
+						"nullable": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+					},
+				},
+			},
+			"primary_key": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Columns of the primary key of a table type.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot("columns"),
+					}...),
+				},
+			},
+			"indexes": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Indexes of a table type.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot("columns"),
+					}...),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional:    true,
+							Description: "Name of the index. Required for non-unique indexes, not allowed for unique indexes which are named by the system.",
+						},
+						"columns": schema.ListAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+						},
+						"unique": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r TypeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var data TypeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, index := range data.Indexes {
+		if index.Unique.IsUnknown() || index.Name.IsUnknown() {
+			continue
+		}
+		if index.Name.IsNull() != index.Unique.ValueBool() {
+			logging.AddAttributeError(ctx, path.Root("indexes").AtListIndex(i).AtName("name"), "Invalid attribute configuration",
+				"name is required for non-unique indexes and not allowed for unique indexes, which are named by the system")
+		}
+	}
+}
+
+func stringValues(values []types.String) (result []string) {
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+	return result
+}
+
+func getType(model TypeResourceModel) (userType sql.Type) {
+	userType = sql.Type{
+		IsTableType: model.Columns != nil,
+		BaseType:    model.BaseType.ValueString(),
+		Nullable:    model.Nullable.ValueBool(),
+		PrimaryKey:  stringValues(model.PrimaryKey),
+	}
+
+	for _, column := range model.Columns {
+		userType.Columns = append(userType.Columns, sql.TypeColumn{
+			Name:     column.Name.ValueString(),
+			Type:     column.Type.ValueString(),
+			Nullable: column.Nullable.ValueBool(),
+		})
+	}
+
+	for _, index := range model.Indexes {
+		userType.Indexes = append(userType.Indexes, sql.TypeIndex{
+			Name:    index.Name.ValueString(),
+			Columns: stringValues(index.Columns),
+			Unique:  index.Unique.ValueBool(),
+		})
+	}
+
+	return userType
+}
+
+func equalColumnNames(configured []types.String, actual []string) bool {
+	if len(configured) != len(actual) {
+		return false
+	}
+	for i := range actual {
+		if !strings.EqualFold(configured[i].ValueString(), actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Indexes are equivalent when every configured index matches an index read from the database, regardless of order.
+func equivalentIndexes(configured []TypeIndexResourceModel, actual []sql.TypeIndex) bool {
+	if len(configured) != len(actual) {
+		return false
+	}
+
+	matched := make([]bool, len(actual))
+	for _, index := range configured {
+		found := false
+		for i, actualIndex := range actual {
+			if !matched[i] &&
+				index.Unique.ValueBool() == actualIndex.Unique &&
+				(actualIndex.Unique || strings.EqualFold(index.Name.ValueString(), actualIndex.Name)) &&
+				equalColumnNames(index.Columns, actualIndex.Columns) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Update the state with the definition read from the database. Equivalent definitions
+// (e.g. `NVARCHAR(50)` and `nvarchar(50)`) are kept as configured.
+func setState(model *TypeResourceModel, userType sql.Type) {
+	model.Id = types.StringValue(userType.Id)
+	model.TypeId = types.Int64Value(userType.TypeId)
+	model.Name = types.StringValue(userType.Name)
+	model.Schema = types.StringValue(userType.Schema)
+
+	if !userType.IsTableType {
+		if !sql.IsTypeDefinitionEquivalent(model.BaseType.ValueString(), userType.BaseType) {
+			model.BaseType = types.StringValue(userType.BaseType)
+		}
+		model.Nullable = types.BoolValue(userType.Nullable)
+		model.Columns = nil
+		return
+	}
+
+	model.BaseType = types.StringNull()
+	if model.Nullable.IsNull() || model.Nullable.IsUnknown() {
+		model.Nullable = types.BoolValue(true)
+	}
+
+	if !equalColumnNames(model.PrimaryKey, userType.PrimaryKey) {
+		model.PrimaryKey = nil
+		for _, column := range userType.PrimaryKey {
+			model.PrimaryKey = append(model.PrimaryKey, types.StringValue(column))
+		}
+	}
+
+	if !equivalentIndexes(model.Indexes, userType.Indexes) {
+		model.Indexes = nil
+		for _, index := range userType.Indexes {
+			name := types.StringNull()
+			if !index.Unique {
+				name = types.StringValue(index.Name)
+			}
+			var columns []types.String
+			for _, column := range index.Columns {
+				columns = append(columns, types.StringValue(column))
+			}
+			model.Indexes = append(model.Indexes, TypeIndexResourceModel{
+				Name:    name,
+				Columns: columns,
+				Unique:  types.BoolValue(index.Unique),
+			})
+		}
+	}
+
+	primaryKey := map[string]bool{}
+	for _, column := range userType.PrimaryKey {
+		primaryKey[column] = true
+	}
+
+	equivalent := len(model.Columns) == len(userType.Columns)
+	for i := 0; equivalent && i < len(userType.Columns); i++ {
+		configured, actual := model.Columns[i], userType.Columns[i]
+		equivalent = configured.Name.ValueString() == actual.Name &&
+			sql.IsTypeDefinitionEquivalent(configured.Type.ValueString(), actual.Type) &&
+			// primary key columns are never nullable
+			(configured.Nullable.ValueBool() == actual.Nullable || primaryKey[actual.Name])
+	}
+
+	if !equivalent {
+		model.Columns = []TypeColumnResourceModel{}
+		for _, column := range userType.Columns {
+			model.Columns = append(model.Columns, TypeColumnResourceModel{
+				Name:     types.StringValue(column.Name),
+				Type:     types.StringValue(column.Type),
+				Nullable: types.BoolValue(column.Nullable),
+			})
+		}
+	}
+}
+
+func (r *TypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var plan TypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	database := plan.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.Provider != "sqlserver" {
+		logging.AddError(ctx, "Invalid config",
+			"`azuresql_type` resource is only supported on SQL databases.")
+		return
+	}
+
+	userType := sql.CreateType(ctx, connection, name, plan.Schema.ValueString(), getType(plan))
+
+	if logging.HasError(ctx) {
+		if userType.Id != "" {
+			logging.AddError(
+				ctx,
+				"Type already exists",
+				fmt.Sprintf("You can import this resource using `terraform import azuresql_type.<name> %s", userType.Id))
+		}
+		return
+	}
+
+	plan.Id = types.StringValue(userType.Id)
+	plan.TypeId = types.Int64Value(userType.TypeId)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state TypeResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	userType := sql.GetTypeFromId(ctx, connection, state.Id.ValueString(), false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if userType.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setState(&state, userType)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	cache, ok := req.ProviderData.(*sql.ConnectionCache)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sql.Server, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionCache = cache
+}
+
+func (r *TypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+}
+
+func (r *TypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state TypeResourceModel
+
+	// Read input configured in data block
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &state)...,
+	)
+
+	database := state.Database.ValueString()
+	connection := r.ConnectionCache.Connect(ctx, database, false, false)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if connection.ConnectionResourceStatus == sql.ConnectionResourceStatusNotFound {
+		return
+	}
+
+	sql.DropType(ctx, connection, state.Id.ValueString())
+
+	if logging.HasError(ctx) {
+		resp.Diagnostics.AddError("Dropping type failed", fmt.Sprintf("Dropping type %s failed", state.Name.ValueString()))
+	}
+}
+
+func (r *TypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Importing type %s", req.ID))
+
+	userType := sql.ParseTypeId(ctx, req.ID)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, userType.Connection, false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	userType = sql.GetTypeFromId(ctx, connection, req.ID, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	state := TypeResourceModel{
+		Database: types.StringValue(userType.Connection),
+		BaseType: types.StringNull(),
+		Nullable: types.BoolNull(),
+	}
+	setState(&state, userType)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
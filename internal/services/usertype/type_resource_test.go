@@ -0,0 +1,164 @@
+package usertype_test
+
+import (
+	"fmt"
+	"terraform-provider-azuresql/internal/acceptance"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type TypeResource struct{}
+
+func TestAccCreateAliasType(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := TypeResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.alias(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.alias(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_type.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func TestAccCreateTableTypeWithProcedure(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := TypeResource{}
+
+	connections := []string{
+		data.SQLDatabase_connection,
+	}
+
+	for _, connection := range connections {
+		print(fmt.Sprintf("\n\nRunning test for connection %s\n\n", connection))
+
+		resource.Test(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:                   r.table(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				},
+				{
+					Config:                   r.table(connection, data.RandomString),
+					ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+					ResourceName:             "azuresql_type.test",
+					ImportState:              true,
+					ImportStateVerify:        true,
+				},
+			},
+		})
+	}
+}
+
+func (r TypeResource) alias(connection string, name string) string {
+	template := r.template(connection)
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_type" "test" {
+			database 	= "%[2]s"
+			name        = "tftype_%[3]s"
+			schema		= data.azuresql_schema.dbo.id
+			base_type	= "nvarchar(50)"
+			nullable	= false
+		}
+		`, template, connection, name)
+}
+
+func (r TypeResource) table(connection string, name string) string {
+	template := r.template(connection)
+
+	return fmt.Sprintf(
+		`
+		%[1]s
+
+		resource "azuresql_type" "test" {
+			database 	= "%[2]s"
+			name        = "tftype_%[3]s"
+			schema		= data.azuresql_schema.dbo.id
+			columns		= [
+				{ name = "id", type = "int" },
+				{ name = "name", type = "NVARCHAR(100)" },
+				{ name = "amount", type = "decimal" },
+			]
+			primary_key	= ["id"]
+			indexes		= [
+				{ name = "ix_name", columns = ["name"] },
+				{ columns = ["name", "amount"], unique = true },
+			]
+		}
+
+		resource "azuresql_procedure" "test" {
+			database 	= "%[2]s"
+			name        = "tfprocedure_%[3]s"
+			schema		= data.azuresql_schema.dbo.id
+			properties	= {
+				arguments = [
+					{
+						name		= "rows"
+						type		= "dbo.tftype_%[3]s"
+						readonly	= true
+					},
+					{
+						name		= "total"
+						type		= "int"
+						output		= true
+					},
+					{
+						name		= "minimum"
+						type		= "int"
+						default		= "0"
+					}
+				]
+				definition = "select @total = count(*) from @rows where id >= @minimum"
+			}
+			depends_on = [azuresql_type.test]
+		}
+
+		data "azuresql_role" "public" {
+			database 	= "%[2]s"
+			name		= "public"
+		}
+
+		resource "azuresql_permission" "test" {
+			database 	= "%[2]s"
+			scope		= azuresql_type.test.id
+			principal	= data.azuresql_role.public.id
+			permission	= "execute"
+		}
+		`, template, connection, name)
+}
+
+func (r TypeResource) template(connection string) string {
+	return fmt.Sprintf(`
+		provider "azuresql" {
+		}
+
+		data "azuresql_schema" "dbo" {
+			database 	= "%[1]s"
+			name 		= "dbo"
+		}
+	`, connection)
+}
@@ -52,10 +52,7 @@ func (r *ViewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "Name of the view",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Description: "Name of the view. Renaming the view keeps its object id and permissions.",
 			},
 			"object_id": schema.Int64Attribute{
 				Computed:    true,
@@ -211,7 +208,47 @@ func (r *ViewResource) Configure(_ context.Context, req resource.ConfigureReques
 }
 
 func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = logging.WithDiagnostics(ctx, &resp.Diagnostics)
+
+	var state, plan ViewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection := r.ConnectionCache.Connect(ctx, state.Database.ValueString(), false, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
 
+	renamed := !state.Name.Equal(plan.Name)
+	if renamed {
+		sql.RenameObject(ctx, connection, state.ObjectId.ValueInt64(), plan.Name.ValueString())
+
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+
+	// altering the view applies the options and updates the name in its definition after a rename
+	if renamed || !state.Schemabinding.Equal(plan.Schemabinding) || !state.CheckOption.Equal(plan.CheckOption) {
+		sql.AlterView(ctx, connection, state.Id.ValueString(), plan.Definition.ValueString(), plan.Schemabinding.ValueBool(), plan.CheckOption.ValueBool())
+
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+
+	plan.Id = state.Id
+	plan.ObjectId = state.ObjectId
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -98,6 +98,42 @@ func TestAccCreateViewWithEOT(t *testing.T) {
 	}
 }
 
+func TestAccRenameView(t *testing.T) {
+	acceptance.PreCheck(t)
+	data := acceptance.BuildTestData(t)
+	r := ViewResource{}
+
+	var objectId string
+
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:                   r.basic(data.SQLDatabase_connection, data.RandomString),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("azuresql_view.test", "object_id", func(value string) error {
+						objectId = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config:                   r.basic(data.SQLDatabase_connection, data.RandomString+"_renamed"),
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuresql_view.test", "name", "tfview_"+data.RandomString+"_renamed"),
+					resource.TestCheckResourceAttrWith("azuresql_view.test", "object_id", func(value string) error {
+						if value != objectId {
+							return fmt.Errorf("object id changed from %s to %s", objectId, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func (r ViewResource) basic(connection string, name string) string {
 	template := r.template()
 
@@ -0,0 +1,142 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+// Asymmetric key generated by SQL. T-SQL can only import asymmetric keys from files, which
+// aren't available in Azure SQL, use a certificate to import an existing key pair instead.
+type AsymmetricKey struct {
+	Id              string
+	Connection      string
+	Name            string
+	AsymmetricKeyId int64
+	Algorithm       string
+	Thumbprint      string
+}
+
+func asymmetricKeyFormatId(connectionId string, asymmetricKeyId int64) string {
+	return fmt.Sprintf("%s/asymmetrickey/%d", connectionId, asymmetricKeyId)
+}
+
+func isAsymmetricKeyId(id string) bool {
+	return strings.Contains(id, "/asymmetrickey/")
+}
+
+func ParseAsymmetricKeyId(ctx context.Context, id string) (asymmetricKey AsymmetricKey) {
+	s := strings.Split(id, "/asymmetrickey/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /asymmetrickey/ exactly once")
+		return
+	}
+
+	asymmetricKey.Connection = s[0]
+
+	asymmetricKeyId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse asymmetric key id")
+		return
+	}
+
+	asymmetricKey.AsymmetricKeyId = asymmetricKeyId
+
+	return
+}
+
+// Create an asymmetric key, the private key is encrypted by the database master key.
+func CreateAsymmetricKey(ctx context.Context, connection Connection, name string, algorithm string) AsymmetricKey {
+
+	query := fmt.Sprintf("create asymmetric key %s with algorithm = %s", quoteIdentifier(name), algorithm)
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, "Asymmetric key creation failed", err)
+		return AsymmetricKey{}
+	}
+
+	asymmetricKey := GetAsymmetricKeyFromName(ctx, connection, name, false)
+	if !logging.HasError(ctx) && asymmetricKey.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created asymmetric key", fmt.Sprintf("Unable to read asymmetric key %s after creation.", name))
+	}
+
+	return asymmetricKey
+}
+
+func GetAsymmetricKeyFromName(ctx context.Context, connection Connection, name string, requiresExist bool) (asymmetricKey AsymmetricKey) {
+	var asymmetricKeyId int64
+	query := "select asymmetric_key_id from sys.asymmetric_keys where name = @name"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name)).Scan(&asymmetricKeyId)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Asymmetric key not found", fmt.Sprintf("Asymmetric key with name %s doesn't exist", name))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading asymmetric key %s failed", name), err)
+		return
+	}
+
+	return GetAsymmetricKeyFromAsymmetricKeyId(ctx, connection, asymmetricKeyId, requiresExist)
+}
+
+func GetAsymmetricKeyFromAsymmetricKeyId(ctx context.Context, connection Connection, asymmetricKeyId int64, requiresExist bool) (asymmetricKey AsymmetricKey) {
+	var thumbprint []byte
+
+	query := "select name, algorithm_desc, thumbprint from sys.asymmetric_keys where asymmetric_key_id = @asymmetric_key_id"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("asymmetric_key_id", asymmetricKeyId)).Scan(
+		&asymmetricKey.Name, &asymmetricKey.Algorithm, &thumbprint)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Asymmetric key not found", fmt.Sprintf("Asymmetric key with id %d doesn't exist", asymmetricKeyId))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading asymmetric key %d failed", asymmetricKeyId), err)
+		return
+	}
+
+	asymmetricKey.Id = asymmetricKeyFormatId(connection.ConnectionId, asymmetricKeyId)
+	asymmetricKey.Connection = connection.ConnectionId
+	asymmetricKey.AsymmetricKeyId = asymmetricKeyId
+	asymmetricKey.Thumbprint = strings.ToUpper(hex.EncodeToString(thumbprint))
+
+	return
+}
+
+func GetAsymmetricKeyFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (asymmetricKey AsymmetricKey) {
+	asymmetricKey = ParseAsymmetricKeyId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if asymmetricKey.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	return GetAsymmetricKeyFromAsymmetricKeyId(ctx, connection, asymmetricKey.AsymmetricKeyId, requiresExist)
+}
+
+func DropAsymmetricKey(ctx context.Context, connection Connection, id string) {
+
+	asymmetricKey := GetAsymmetricKeyFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || asymmetricKey.Id == "" {
+		return
+	}
+
+	query := fmt.Sprintf("drop asymmetric key %s", quoteIdentifier(asymmetricKey.Name))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping asymmetric key %s failed", asymmetricKey.Name), err)
+	}
+}
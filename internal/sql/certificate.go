@@ -0,0 +1,289 @@
+package sql
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"database/sql"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+type Certificate struct {
+	Id            string
+	Connection    string
+	Name          string
+	CertificateId int64
+	Subject       string
+	StartDate     string
+	ExpiryDate    string
+	Thumbprint    string
+	HasPrivateKey bool
+}
+
+func certificateFormatId(connectionId string, certificateId int64) string {
+	return fmt.Sprintf("%s/certificate/%d", connectionId, certificateId)
+}
+
+func isCertificateId(id string) bool {
+	return strings.Contains(id, "/certificate/")
+}
+
+func ParseCertificateId(ctx context.Context, id string) (certificate Certificate) {
+	s := strings.Split(id, "/certificate/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /certificate/ exactly once")
+		return
+	}
+
+	certificate.Connection = s[0]
+
+	certificateId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse certificate id")
+		return
+	}
+
+	certificate.CertificateId = certificateId
+
+	return
+}
+
+// Parse a PEM value, containing a certificate and optionally an RSA private key, or a base64 encoded PFX.
+// Returns the DER encoded certificate and the private key in the PVK format expected by SQL Server.
+func parseCertificateValue(value string, password string) (certificate []byte, privateKey []byte, err error) {
+	var cert *x509.Certificate
+	var key any
+
+	if strings.Contains(value, "-----BEGIN") {
+		rest := []byte(value)
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			switch block.Type {
+			case "CERTIFICATE":
+				if cert == nil {
+					cert, err = x509.ParseCertificate(block.Bytes)
+				}
+			case "RSA PRIVATE KEY":
+				key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+			case "PRIVATE KEY":
+				key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if cert == nil {
+			return nil, nil, fmt.Errorf("no CERTIFICATE block found in PEM value")
+		}
+	} else {
+		data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+		if err != nil {
+			return nil, nil, fmt.Errorf("value is neither PEM nor a base64 encoded PFX: %w", err)
+		}
+		key, cert, err = pkcs12.Decode(data, password)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if key == nil {
+		return cert.Raw, nil, nil
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("only RSA private keys are supported, got %T", key)
+	}
+
+	if publicKey, ok := cert.PublicKey.(*rsa.PublicKey); !ok || publicKey.N.Cmp(rsaKey.N) != 0 {
+		return nil, nil, fmt.Errorf("private key doesn't match the certificate")
+	}
+
+	privateKey, err = rsaPrivateKeyToPvk(rsaKey)
+	return cert.Raw, privateKey, err
+}
+
+// Encode an RSA private key as an unencrypted PVK file, i.e. a PRIVATEKEYBLOB preceded by a PVK header.
+func rsaPrivateKeyToPvk(key *rsa.PrivateKey) ([]byte, error) {
+	if len(key.Primes) != 2 {
+		return nil, fmt.Errorf("only RSA private keys with 2 primes are supported")
+	}
+	key.Precompute()
+
+	bitLength := key.N.BitLen()
+	if bitLength%16 != 0 {
+		return nil, fmt.Errorf("unsupported RSA key length %d", bitLength)
+	}
+
+	// BLOBHEADER: PRIVATEKEYBLOB, version 2, CALG_RSA_KEYX
+	blob := []byte{0x07, 0x02, 0x00, 0x00}
+	blob = binary.LittleEndian.AppendUint32(blob, 0x0000a400)
+	// RSAPUBKEY: RSA2 magic, bit length, public exponent
+	blob = binary.LittleEndian.AppendUint32(blob, 0x32415352)
+	blob = binary.LittleEndian.AppendUint32(blob, uint32(bitLength))
+	blob = binary.LittleEndian.AppendUint32(blob, uint32(key.E))
+
+	littleEndian := func(bigEndian []byte) {
+		for i := len(bigEndian) - 1; i >= 0; i-- {
+			blob = append(blob, bigEndian[i])
+		}
+	}
+	littleEndian(key.N.FillBytes(make([]byte, bitLength/8)))
+	littleEndian(key.Primes[0].FillBytes(make([]byte, bitLength/16)))
+	littleEndian(key.Primes[1].FillBytes(make([]byte, bitLength/16)))
+	littleEndian(key.Precomputed.Dp.FillBytes(make([]byte, bitLength/16)))
+	littleEndian(key.Precomputed.Dq.FillBytes(make([]byte, bitLength/16)))
+	littleEndian(key.Precomputed.Qinv.FillBytes(make([]byte, bitLength/16)))
+	littleEndian(key.D.FillBytes(make([]byte, bitLength/8)))
+
+	// PVK header: magic, reserved, AT_KEYEXCHANGE, not encrypted, no salt, key length
+	var pvk []byte
+	for _, field := range []uint32{0xb0b5f11e, 0, 1, 0, 0, uint32(len(blob))} {
+		pvk = binary.LittleEndian.AppendUint32(pvk, field)
+	}
+
+	return append(pvk, blob...), nil
+}
+
+func buildCertificateQuery(name string, certificate Certificate, certificateBinary []byte, privateKeyBinary []byte) string {
+	query := fmt.Sprintf("create certificate %s", quoteIdentifier(name))
+
+	if certificateBinary != nil {
+		query += fmt.Sprintf(" from binary = 0x%s", strings.ToUpper(hex.EncodeToString(certificateBinary)))
+		if privateKeyBinary != nil {
+			query += fmt.Sprintf(" with private key (binary = 0x%s)", strings.ToUpper(hex.EncodeToString(privateKeyBinary)))
+		}
+		return query
+	}
+
+	query += fmt.Sprintf(" with subject = %s", quoteString(certificate.Subject))
+	if certificate.StartDate != "" {
+		query += fmt.Sprintf(", start_date = %s", quoteString(certificate.StartDate))
+	}
+	if certificate.ExpiryDate != "" {
+		query += fmt.Sprintf(", expiry_date = %s", quoteString(certificate.ExpiryDate))
+	}
+	return query
+}
+
+// Create a certificate generated by SQL, or imported from value when it isn't empty.
+// The private key is encrypted by the database master key.
+func CreateCertificate(ctx context.Context, connection Connection, name string, certificate Certificate, value string, password string) Certificate {
+	var certificateBinary, privateKeyBinary []byte
+
+	if value != "" {
+		var err error
+		certificateBinary, privateKeyBinary, err = parseCertificateValue(value, password)
+		if err != nil {
+			logging.AddError(ctx, "Invalid certificate", err)
+			return Certificate{}
+		}
+	}
+
+	_, err := connection.Connection.ExecContext(ctx, buildCertificateQuery(name, certificate, certificateBinary, privateKeyBinary))
+
+	if err != nil {
+		logging.AddError(ctx, "Certificate creation failed", err)
+		return Certificate{}
+	}
+
+	certificate = GetCertificateFromName(ctx, connection, name, false)
+	if !logging.HasError(ctx) && certificate.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created certificate", fmt.Sprintf("Unable to read certificate %s after creation.", name))
+	}
+
+	return certificate
+}
+
+func GetCertificateFromName(ctx context.Context, connection Connection, name string, requiresExist bool) (certificate Certificate) {
+	var certificateId int64
+	query := "select certificate_id from sys.certificates where name = @name"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name)).Scan(&certificateId)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Certificate not found", fmt.Sprintf("Certificate with name %s doesn't exist", name))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading certificate %s failed", name), err)
+		return
+	}
+
+	return GetCertificateFromCertificateId(ctx, connection, certificateId, requiresExist)
+}
+
+func GetCertificateFromCertificateId(ctx context.Context, connection Connection, certificateId int64, requiresExist bool) (certificate Certificate) {
+	var thumbprint []byte
+	var encryptionType string
+
+	query := `
+		select name, subject, convert(varchar(10), start_date, 23), convert(varchar(10), expiry_date, 23), thumbprint, pvt_key_encryption_type
+		from sys.certificates
+		where certificate_id = @certificate_id`
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("certificate_id", certificateId)).Scan(
+		&certificate.Name, &certificate.Subject, &certificate.StartDate, &certificate.ExpiryDate, &thumbprint, &encryptionType)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Certificate not found", fmt.Sprintf("Certificate with id %d doesn't exist", certificateId))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading certificate %d failed", certificateId), err)
+		return
+	}
+
+	certificate.Id = certificateFormatId(connection.ConnectionId, certificateId)
+	certificate.Connection = connection.ConnectionId
+	certificate.CertificateId = certificateId
+	certificate.Thumbprint = strings.ToUpper(hex.EncodeToString(thumbprint))
+	// NA means the certificate has no private key
+	certificate.HasPrivateKey = encryptionType != "NA"
+
+	return
+}
+
+func GetCertificateFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (certificate Certificate) {
+	certificate = ParseCertificateId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if certificate.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	return GetCertificateFromCertificateId(ctx, connection, certificate.CertificateId, requiresExist)
+}
+
+func DropCertificate(ctx context.Context, connection Connection, id string) {
+
+	certificate := GetCertificateFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || certificate.Id == "" {
+		return
+	}
+
+	query := fmt.Sprintf("drop certificate %s", quoteIdentifier(certificate.Name))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping certificate %s failed", certificate.Name), err)
+	}
+}
@@ -0,0 +1,111 @@
+package sql
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func generateTestCertificate(t *testing.T) (*rsa.PrivateKey, []byte) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, der
+}
+
+func TestParseCertificateValue(t *testing.T) {
+	key, der := generateTestCertificate(t)
+	certificatePem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPem := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+
+	certificate, privateKey, err := parseCertificateValue(certificatePem, "")
+	assert.NoError(t, err)
+	assert.Equal(t, der, certificate)
+	assert.Nil(t, privateKey)
+
+	certificate, privateKey, err = parseCertificateValue(certificatePem+keyPem, "")
+	assert.NoError(t, err)
+	assert.Equal(t, der, certificate)
+
+	// PVK header, BLOBHEADER and RSAPUBKEY followed by 2 full length and 5 half length components
+	assert.Equal(t, uint32(0xb0b5f11e), binary.LittleEndian.Uint32(privateKey[0:4]))
+	assert.Equal(t, 24+20+2*256+5*128, len(privateKey))
+	assert.Equal(t, "RSA2", string(privateKey[32:36]))
+
+	otherKey, _ := generateTestCertificate(t)
+	otherKeyPem := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(otherKey)}))
+	_, _, err = parseCertificateValue(certificatePem+otherKeyPem, "")
+	assert.Error(t, err, "A private key of another certificate should be rejected.")
+
+	_, _, err = parseCertificateValue("not a certificate", "")
+	assert.Error(t, err)
+}
+
+func TestParseCertificateValuePfx(t *testing.T) {
+	key, der := generateTestCertificate(t)
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pfx, err := pkcs12.Modern.Encode(key, cert, nil, "Passw0rd!")
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := base64.StdEncoding.EncodeToString(pfx)
+
+	certificate, privateKey, err := parseCertificateValue(value, "Passw0rd!")
+	assert.NoError(t, err)
+	assert.Equal(t, der, certificate)
+	assert.Equal(t, "RSA2", string(privateKey[32:36]))
+
+	_, _, err = parseCertificateValue(value, "wrong password")
+	assert.Error(t, err, "A PFX with a wrong password should be rejected.")
+}
+
+func TestBuildCertificateQuery(t *testing.T) {
+	query := buildCertificateQuery("signing", Certificate{Subject: "Module signing", ExpiryDate: "2030-01-01"}, nil, nil)
+	assert.Equal(t, "create certificate [signing] with subject = 'Module signing', expiry_date = '2030-01-01'", query)
+
+	query = buildCertificateQuery("imported", Certificate{}, []byte{0x30, 0x82}, []byte{0x1e, 0xf1})
+	assert.Equal(t, "create certificate [imported] from binary = 0x3082 with private key (binary = 0x1EF1)", query)
+}
+
+func TestParseModuleSignatureId(t *testing.T) {
+	ctx := logging.GetTestContext()
+	signature := ParseModuleSignatureId(ctx, "sqlserver::server:1433:db/modulesignature/123/certificate/256")
+	assert.False(t, logging.HasError(ctx))
+	assert.Equal(t, int64(123), signature.ObjectId)
+	assert.Equal(t, "certificate", signature.KeyType)
+	assert.Equal(t, int64(256), signature.KeyId)
+
+	ctx = logging.GetTestContext()
+	ParseModuleSignatureId(ctx, "sqlserver::server:1433:db/modulesignature/123/key/256")
+	assert.True(t, logging.HasError(ctx))
+	assert.True(t, strings.Contains(moduleSignatureFormatId("db", 1, "asymmetrickey", 2), "/modulesignature/1/asymmetrickey/2"))
+}
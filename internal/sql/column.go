@@ -0,0 +1,122 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+// Column of a table, used as scope of column level permissions.
+type Column struct {
+	Id         string
+	Connection string
+	Name       string
+	TableName  string
+	SchemaName string
+	ObjectId   int64
+	ColumnId   int64
+}
+
+func columnFormatId(connectionId string, objectId int64, columnId int64) string {
+	return fmt.Sprintf("%s/column/%d/%d", connectionId, objectId, columnId)
+}
+
+func isColumnId(id string) bool {
+	return strings.Contains(id, "/column/")
+}
+
+func ParseColumnId(ctx context.Context, id string) (column Column) {
+	s := strings.Split(id, "/column/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /column/ exactly once")
+		return
+	}
+
+	column.Connection = s[0]
+
+	s = strings.Split(s[1], "/")
+	if len(s) != 2 {
+		logging.AddError(ctx, "Invalid id", "Unable to parse column id")
+		return
+	}
+
+	objectId, err := strconv.ParseInt(s[0], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse column id")
+		return
+	}
+
+	columnId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse column id")
+		return
+	}
+
+	column.ObjectId = objectId
+	column.ColumnId = columnId
+
+	return
+}
+
+func GetColumnFromName(ctx context.Context, connection Connection, tableId string, name string, requiresExist bool) (column Column) {
+	table := GetTableFromId(ctx, connection, tableId, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	var columnId int64
+	query := "select column_id from sys.columns where object_id = @object_id and name = @name"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("object_id", table.ObjectId), sql.Named("name", name)).Scan(&columnId)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Column not found", fmt.Sprintf("Column %s doesn't exist in table %s.%s", name, table.SchemaName, table.Name))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading column %s failed", name), err)
+		return
+	}
+
+	return GetColumnFromId(ctx, connection, columnFormatId(connection.ConnectionId, table.ObjectId, columnId), requiresExist)
+}
+
+func GetColumnFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (column Column) {
+	column = ParseColumnId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if column.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	query := `
+		select c.name, t.name, schema_name(t.schema_id)
+		from sys.columns c
+		inner join sys.tables t on t.object_id = c.object_id
+		where c.object_id = @object_id and c.column_id = @column_id`
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("object_id", column.ObjectId), sql.Named("column_id", column.ColumnId)).
+		Scan(&column.Name, &column.TableName, &column.SchemaName)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Column not found", fmt.Sprintf("Column %s doesn't exist", id))
+		}
+		return Column{}
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading column %s failed", id), err)
+		return Column{}
+	}
+
+	column.Id = columnFormatId(connection.ConnectionId, column.ObjectId, column.ColumnId)
+
+	return
+}
@@ -0,0 +1,256 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+// Value of a column encryption key, encrypted by a column master key.
+// ColumnMasterKey is the id of the azuresql_column_master_key.
+type ColumnEncryptionKeyValue struct {
+	ColumnMasterKey string
+	Algorithm       string
+	EncryptedValue  string
+}
+
+// Always Encrypted column encryption key, with one value or two values during rotation of the column master key.
+type ColumnEncryptionKey struct {
+	Id                    string
+	Connection            string
+	Name                  string
+	ColumnEncryptionKeyId int64
+	Values                []ColumnEncryptionKeyValue
+}
+
+func columnEncryptionKeyFormatId(connectionId string, columnEncryptionKeyId int64) string {
+	return fmt.Sprintf("%s/columnencryptionkey/%d", connectionId, columnEncryptionKeyId)
+}
+
+func ParseColumnEncryptionKeyId(ctx context.Context, id string) (columnEncryptionKey ColumnEncryptionKey) {
+	s := strings.Split(id, "/columnencryptionkey/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /columnencryptionkey/ exactly once")
+		return
+	}
+
+	columnEncryptionKey.Connection = s[0]
+
+	columnEncryptionKeyId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse column encryption key id")
+		return
+	}
+
+	columnEncryptionKey.ColumnEncryptionKeyId = columnEncryptionKeyId
+
+	return
+}
+
+func formatColumnEncryptionKeyValue(columnMasterKeyName string, value ColumnEncryptionKeyValue) string {
+	return fmt.Sprintf("(column_master_key = %s, algorithm = %s, encrypted_value = %s)",
+		quoteIdentifier(columnMasterKeyName), quoteString(value.Algorithm), value.EncryptedValue)
+}
+
+func columnMasterKeyName(ctx context.Context, connection Connection, columnMasterKeyResourceId string) string {
+	return GetColumnMasterKeyFromId(ctx, connection, columnMasterKeyResourceId, true).Name
+}
+
+func CreateColumnEncryptionKey(ctx context.Context, connection Connection, name string, values []ColumnEncryptionKeyValue) ColumnEncryptionKey {
+
+	var formatted []string
+	for _, value := range values {
+		cmk := columnMasterKeyName(ctx, connection, value.ColumnMasterKey)
+		if logging.HasError(ctx) {
+			return ColumnEncryptionKey{}
+		}
+		formatted = append(formatted, formatColumnEncryptionKeyValue(cmk, value))
+	}
+
+	query := fmt.Sprintf("create column encryption key %s with values %s", quoteIdentifier(name), strings.Join(formatted, ", "))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, "Column encryption key creation failed", err)
+		return ColumnEncryptionKey{}
+	}
+
+	columnEncryptionKey := GetColumnEncryptionKeyFromName(ctx, connection, name, false)
+	if !logging.HasError(ctx) && columnEncryptionKey.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created column encryption key", fmt.Sprintf("Unable to read column encryption key %s after creation.", name))
+	}
+
+	return columnEncryptionKey
+}
+
+func GetColumnEncryptionKeyFromName(ctx context.Context, connection Connection, name string, requiresExist bool) (columnEncryptionKey ColumnEncryptionKey) {
+	var columnEncryptionKeyId int64
+	query := "select column_encryption_key_id from sys.column_encryption_keys where name = @name"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name)).Scan(&columnEncryptionKeyId)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Column encryption key not found", fmt.Sprintf("Column encryption key with name %s doesn't exist", name))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading column encryption key %s failed", name), err)
+		return
+	}
+
+	return GetColumnEncryptionKeyFromColumnEncryptionKeyId(ctx, connection, columnEncryptionKeyId, requiresExist)
+}
+
+func GetColumnEncryptionKeyFromColumnEncryptionKeyId(ctx context.Context, connection Connection, columnEncryptionKeyId int64, requiresExist bool) (columnEncryptionKey ColumnEncryptionKey) {
+	query := "select name from sys.column_encryption_keys where column_encryption_key_id = @column_encryption_key_id"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("column_encryption_key_id", columnEncryptionKeyId)).Scan(&columnEncryptionKey.Name)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Column encryption key not found", fmt.Sprintf("Column encryption key with id %d doesn't exist", columnEncryptionKeyId))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading column encryption key %d failed", columnEncryptionKeyId), err)
+		return
+	}
+
+	columnEncryptionKey.Id = columnEncryptionKeyFormatId(connection.ConnectionId, columnEncryptionKeyId)
+	columnEncryptionKey.Connection = connection.ConnectionId
+	columnEncryptionKey.ColumnEncryptionKeyId = columnEncryptionKeyId
+
+	query = `
+		select column_master_key_id, encryption_algorithm_name, encrypted_value
+		from sys.column_encryption_key_values
+		where column_encryption_key_id = @column_encryption_key_id
+		order by column_master_key_id`
+
+	rows, err := connection.Connection.QueryContext(ctx, query, sql.Named("column_encryption_key_id", columnEncryptionKeyId))
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading values of column encryption key %d failed", columnEncryptionKeyId), err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var columnMasterKeyId int64
+		var value ColumnEncryptionKeyValue
+		var encryptedValue []byte
+
+		if err := rows.Scan(&columnMasterKeyId, &value.Algorithm, &encryptedValue); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Reading values of column encryption key %d failed", columnEncryptionKeyId), err)
+			return
+		}
+
+		value.ColumnMasterKey = columnMasterKeyFormatId(connection.ConnectionId, columnMasterKeyId)
+		value.EncryptedValue = formatBinary(encryptedValue)
+		columnEncryptionKey.Values = append(columnEncryptionKey.Values, value)
+	}
+
+	return
+}
+
+func GetColumnEncryptionKeyFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (columnEncryptionKey ColumnEncryptionKey) {
+	columnEncryptionKey = ParseColumnEncryptionKeyId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if columnEncryptionKey.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	return GetColumnEncryptionKeyFromColumnEncryptionKeyId(ctx, connection, columnEncryptionKey.ColumnEncryptionKeyId, requiresExist)
+}
+
+// Add and drop values of a column encryption key, e.g. to rotate its column master key.
+// A column encryption key has at most two values and at least one, so a removed value is
+// dropped before adding a new value when the key already has two values.
+func UpdateColumnEncryptionKey(ctx context.Context, connection Connection, id string, values []ColumnEncryptionKeyValue) {
+	current := GetColumnEncryptionKeyFromId(ctx, connection, id, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	contains := func(values []ColumnEncryptionKeyValue, columnMasterKey string) bool {
+		for _, value := range values {
+			if value.ColumnMasterKey == columnMasterKey {
+				return true
+			}
+		}
+		return false
+	}
+
+	var added, removed []ColumnEncryptionKeyValue
+	for _, value := range values {
+		if !contains(current.Values, value.ColumnMasterKey) {
+			added = append(added, value)
+		}
+	}
+	for _, value := range current.Values {
+		if !contains(values, value.ColumnMasterKey) {
+			removed = append(removed, value)
+		}
+	}
+
+	exec := func(action string, value string) {
+		query := fmt.Sprintf("alter column encryption key %s %s value %s", quoteIdentifier(current.Name), action, value)
+		if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Updating column encryption key %s failed", current.Name), err)
+		}
+	}
+
+	drop := func(value ColumnEncryptionKeyValue) {
+		cmk := columnMasterKeyName(ctx, connection, value.ColumnMasterKey)
+		if logging.HasError(ctx) {
+			return
+		}
+		exec("drop", fmt.Sprintf("(column_master_key = %s)", quoteIdentifier(cmk)))
+	}
+
+	count := len(current.Values)
+	for _, value := range added {
+		if count >= 2 && len(removed) > 0 {
+			drop(removed[0])
+			removed = removed[1:]
+			count--
+		}
+
+		cmk := columnMasterKeyName(ctx, connection, value.ColumnMasterKey)
+		if logging.HasError(ctx) {
+			return
+		}
+		exec("add", formatColumnEncryptionKeyValue(cmk, value))
+		if logging.HasError(ctx) {
+			return
+		}
+		count++
+	}
+
+	for _, value := range removed {
+		drop(value)
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+}
+
+func DropColumnEncryptionKey(ctx context.Context, connection Connection, id string) {
+
+	columnEncryptionKey := GetColumnEncryptionKeyFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || columnEncryptionKey.Id == "" {
+		return
+	}
+
+	query := fmt.Sprintf("drop column encryption key %s", quoteIdentifier(columnEncryptionKey.Name))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping column encryption key %s failed", columnEncryptionKey.Name), err)
+	}
+}
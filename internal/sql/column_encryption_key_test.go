@@ -0,0 +1,40 @@
+package sql
+
+import (
+	"terraform-provider-azuresql/internal/logging"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildColumnMasterKeyQuery(t *testing.T) {
+	query := buildColumnMasterKeyQuery("cmk", ColumnMasterKey{KeyStoreProviderName: "AZURE_KEY_VAULT", KeyPath: "https://vault.vault.azure.net/keys/cmk/1"})
+	assert.Equal(t, "create column master key [cmk] with (key_store_provider_name = 'AZURE_KEY_VAULT', key_path = 'https://vault.vault.azure.net/keys/cmk/1')", query)
+
+	query = buildColumnMasterKeyQuery("cmk", ColumnMasterKey{KeyStoreProviderName: "MSSQL_CERTIFICATE_STORE", KeyPath: "CurrentUser/My/0A", Signature: "0x01AB"})
+	assert.Equal(t, "create column master key [cmk] with (key_store_provider_name = 'MSSQL_CERTIFICATE_STORE', key_path = 'CurrentUser/My/0A', enclave_computations (signature = 0x01AB))", query)
+}
+
+func TestFormatColumnEncryptionKeyValue(t *testing.T) {
+	value := formatColumnEncryptionKeyValue("cmk", ColumnEncryptionKeyValue{Algorithm: "RSA_OAEP", EncryptedValue: "0x016E"})
+	assert.Equal(t, "(column_master_key = [cmk], algorithm = 'RSA_OAEP', encrypted_value = 0x016E)", value)
+}
+
+func TestIsBinaryEquivalent(t *testing.T) {
+	assert.True(t, IsBinaryEquivalent("0x01ab", "0x01AB"))
+	assert.True(t, IsBinaryEquivalent("01AB", "0x01AB"))
+	assert.False(t, IsBinaryEquivalent("0x01AB", "0x01AC"))
+	assert.Equal(t, "0x01AB", formatBinary([]byte{0x01, 0xab}))
+}
+
+func TestParseColumnEncryptionKeyId(t *testing.T) {
+	ctx := logging.GetTestContext()
+	columnEncryptionKey := ParseColumnEncryptionKeyId(ctx, "sqlserver::server:1433:db/columnencryptionkey/3")
+	assert.False(t, logging.HasError(ctx))
+	assert.Equal(t, "sqlserver::server:1433:db", columnEncryptionKey.Connection)
+	assert.Equal(t, int64(3), columnEncryptionKey.ColumnEncryptionKeyId)
+
+	ctx = logging.GetTestContext()
+	ParseColumnMasterKeyId(ctx, "sqlserver::server:1433:db/columnencryptionkey/3")
+	assert.True(t, logging.HasError(ctx))
+}
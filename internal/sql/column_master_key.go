@@ -0,0 +1,166 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+// Always Encrypted column master key. The key itself is stored in an external key store,
+// the database only contains its metadata.
+type ColumnMasterKey struct {
+	Id                       string
+	Connection               string
+	Name                     string
+	ColumnMasterKeyId        int64
+	KeyStoreProviderName     string
+	KeyPath                  string
+	AllowEnclaveComputations bool
+	Signature                string
+}
+
+func columnMasterKeyFormatId(connectionId string, columnMasterKeyId int64) string {
+	return fmt.Sprintf("%s/columnmasterkey/%d", connectionId, columnMasterKeyId)
+}
+
+func ParseColumnMasterKeyId(ctx context.Context, id string) (columnMasterKey ColumnMasterKey) {
+	s := strings.Split(id, "/columnmasterkey/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /columnmasterkey/ exactly once")
+		return
+	}
+
+	columnMasterKey.Connection = s[0]
+
+	columnMasterKeyId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse column master key id")
+		return
+	}
+
+	columnMasterKey.ColumnMasterKeyId = columnMasterKeyId
+
+	return
+}
+
+// Format a binary value as a T-SQL binary constant, e.g. 0x01AB.
+func formatBinary(value []byte) string {
+	return "0x" + strings.ToUpper(hex.EncodeToString(value))
+}
+
+// Compare two binary constants, ignoring case and the optional 0x prefix.
+func IsBinaryEquivalent(value1 string, value2 string) bool {
+	normalize := func(value string) string {
+		return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(value)), "0x")
+	}
+	return normalize(value1) == normalize(value2)
+}
+
+func buildColumnMasterKeyQuery(name string, columnMasterKey ColumnMasterKey) string {
+	query := fmt.Sprintf("create column master key %s with (key_store_provider_name = %s, key_path = %s",
+		quoteIdentifier(name), quoteString(columnMasterKey.KeyStoreProviderName), quoteString(columnMasterKey.KeyPath))
+
+	if columnMasterKey.Signature != "" {
+		query += fmt.Sprintf(", enclave_computations (signature = %s)", columnMasterKey.Signature)
+	}
+
+	return query + ")"
+}
+
+func CreateColumnMasterKey(ctx context.Context, connection Connection, name string, columnMasterKey ColumnMasterKey) ColumnMasterKey {
+
+	if _, err := connection.Connection.ExecContext(ctx, buildColumnMasterKeyQuery(name, columnMasterKey)); err != nil {
+		logging.AddError(ctx, "Column master key creation failed", err)
+		return ColumnMasterKey{}
+	}
+
+	columnMasterKey = GetColumnMasterKeyFromName(ctx, connection, name, false)
+	if !logging.HasError(ctx) && columnMasterKey.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created column master key", fmt.Sprintf("Unable to read column master key %s after creation.", name))
+	}
+
+	return columnMasterKey
+}
+
+func GetColumnMasterKeyFromName(ctx context.Context, connection Connection, name string, requiresExist bool) (columnMasterKey ColumnMasterKey) {
+	var columnMasterKeyId int64
+	query := "select column_master_key_id from sys.column_master_keys where name = @name"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name)).Scan(&columnMasterKeyId)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Column master key not found", fmt.Sprintf("Column master key with name %s doesn't exist", name))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading column master key %s failed", name), err)
+		return
+	}
+
+	return GetColumnMasterKeyFromColumnMasterKeyId(ctx, connection, columnMasterKeyId, requiresExist)
+}
+
+func GetColumnMasterKeyFromColumnMasterKeyId(ctx context.Context, connection Connection, columnMasterKeyId int64, requiresExist bool) (columnMasterKey ColumnMasterKey) {
+	var signature []byte
+
+	query := `
+		select name, key_store_provider_name, key_path, allow_enclave_computations, signature
+		from sys.column_master_keys
+		where column_master_key_id = @column_master_key_id`
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("column_master_key_id", columnMasterKeyId)).Scan(
+		&columnMasterKey.Name, &columnMasterKey.KeyStoreProviderName, &columnMasterKey.KeyPath, &columnMasterKey.AllowEnclaveComputations, &signature)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Column master key not found", fmt.Sprintf("Column master key with id %d doesn't exist", columnMasterKeyId))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading column master key %d failed", columnMasterKeyId), err)
+		return
+	}
+
+	columnMasterKey.Id = columnMasterKeyFormatId(connection.ConnectionId, columnMasterKeyId)
+	columnMasterKey.Connection = connection.ConnectionId
+	columnMasterKey.ColumnMasterKeyId = columnMasterKeyId
+	if signature != nil {
+		columnMasterKey.Signature = formatBinary(signature)
+	}
+
+	return
+}
+
+func GetColumnMasterKeyFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (columnMasterKey ColumnMasterKey) {
+	columnMasterKey = ParseColumnMasterKeyId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if columnMasterKey.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	return GetColumnMasterKeyFromColumnMasterKeyId(ctx, connection, columnMasterKey.ColumnMasterKeyId, requiresExist)
+}
+
+func DropColumnMasterKey(ctx context.Context, connection Connection, id string) {
+
+	columnMasterKey := GetColumnMasterKeyFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || columnMasterKey.Id == "" {
+		return
+	}
+
+	query := fmt.Sprintf("drop column master key %s", quoteIdentifier(columnMasterKey.Name))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping column master key %s failed", columnMasterKey.Name), err)
+	}
+}
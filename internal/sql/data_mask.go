@@ -0,0 +1,230 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+// Dynamic data mask on a column. Prefix, Padding and Suffix are only used by the partial function,
+// Start and End only by the random function.
+type DataMask struct {
+	Id         string
+	Connection string
+	Table      string
+	Column     string
+	ObjectId   int64
+	ColumnId   int64
+	Function   string
+	Prefix     int64
+	Padding    string
+	Suffix     int64
+	Start      float64
+	End        float64
+}
+
+var (
+	partialMaskRegex = regexp.MustCompile(`^partial\(\s*(\d+)\s*,\s*"([^"]*)"\s*,\s*(\d+)\s*\)$`)
+	randomMaskRegex  = regexp.MustCompile(`^random\(\s*([-+0-9.eE]+)\s*,\s*([-+0-9.eE]+)\s*\)$`)
+)
+
+func dataMaskFormatId(connectionId string, objectId int64, columnId int64) string {
+	return fmt.Sprintf("%s/datamask/%d/%d", connectionId, objectId, columnId)
+}
+
+func ParseDataMaskId(ctx context.Context, id string) (dataMask DataMask) {
+	s := strings.Split(id, "/datamask/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /datamask/ exactly once")
+		return
+	}
+
+	dataMask.Connection = s[0]
+
+	s = strings.Split(s[1], "/")
+	if len(s) != 2 {
+		logging.AddError(ctx, "Invalid id", "Unable to parse data mask id")
+		return
+	}
+
+	objectId, err := strconv.ParseInt(s[0], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse data mask id")
+		return
+	}
+
+	columnId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse data mask id")
+		return
+	}
+
+	dataMask.ObjectId = objectId
+	dataMask.ColumnId = columnId
+
+	return
+}
+
+func formatMaskingFunction(dataMask DataMask) string {
+	switch dataMask.Function {
+	case "partial":
+		return fmt.Sprintf(`partial(%d, "%s", %d)`, dataMask.Prefix, dataMask.Padding, dataMask.Suffix)
+	case "random":
+		return fmt.Sprintf("random(%s, %s)",
+			strconv.FormatFloat(dataMask.Start, 'f', -1, 64), strconv.FormatFloat(dataMask.End, 'f', -1, 64))
+	default:
+		return dataMask.Function + "()"
+	}
+}
+
+func parseMaskingFunction(maskingFunction string) (dataMask DataMask, err error) {
+	maskingFunction = strings.TrimSpace(maskingFunction)
+
+	switch {
+	case strings.EqualFold(maskingFunction, "default()"):
+		dataMask.Function = "default"
+	case strings.EqualFold(maskingFunction, "email()"):
+		dataMask.Function = "email"
+	case partialMaskRegex.MatchString(maskingFunction):
+		m := partialMaskRegex.FindStringSubmatch(maskingFunction)
+		dataMask.Function = "partial"
+		dataMask.Prefix, _ = strconv.ParseInt(m[1], 10, 64)
+		dataMask.Padding = m[2]
+		dataMask.Suffix, _ = strconv.ParseInt(m[3], 10, 64)
+	case randomMaskRegex.MatchString(maskingFunction):
+		m := randomMaskRegex.FindStringSubmatch(maskingFunction)
+		dataMask.Function = "random"
+		if dataMask.Start, err = strconv.ParseFloat(m[1], 64); err != nil {
+			return
+		}
+		dataMask.End, err = strconv.ParseFloat(m[2], 64)
+	default:
+		err = fmt.Errorf("unsupported masking function %s", maskingFunction)
+	}
+
+	return
+}
+
+func alterDataMask(ctx context.Context, connection Connection, column Column, dataMask DataMask) {
+	query := fmt.Sprintf("alter table %s.%s alter column %s add masked with (function = %s)",
+		quoteIdentifier(column.SchemaName), quoteIdentifier(column.TableName), quoteIdentifier(column.Name), quoteString(formatMaskingFunction(dataMask)))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Masking column %s.%s.%s failed", column.SchemaName, column.TableName, column.Name), err)
+	}
+}
+
+func CreateDataMask(ctx context.Context, connection Connection, dataMask DataMask) DataMask {
+
+	column := GetColumnFromName(ctx, connection, dataMask.Table, dataMask.Column, true)
+	if logging.HasError(ctx) {
+		return DataMask{}
+	}
+
+	existing := GetDataMaskFromId(ctx, connection, dataMaskFormatId(connection.ConnectionId, column.ObjectId, column.ColumnId), false)
+	if logging.HasError(ctx) {
+		return DataMask{}
+	}
+	if existing.Id != "" {
+		logging.AddError(ctx, "Data mask already exists", fmt.Sprintf("Column %s.%s.%s is already masked", column.SchemaName, column.TableName, column.Name))
+		return existing
+	}
+
+	alterDataMask(ctx, connection, column, dataMask)
+	if logging.HasError(ctx) {
+		return DataMask{}
+	}
+
+	dataMask = GetDataMaskFromId(ctx, connection, dataMaskFormatId(connection.ConnectionId, column.ObjectId, column.ColumnId), false)
+	if !logging.HasError(ctx) && dataMask.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created data mask", fmt.Sprintf("Unable to read data mask of column %s after creation.", column.Name))
+	}
+
+	return dataMask
+}
+
+func GetDataMaskFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (dataMask DataMask) {
+	dataMask = ParseDataMaskId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if dataMask.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	var column, maskingFunction string
+	query := `
+		select name, masking_function
+		from sys.masked_columns
+		where object_id = @object_id and column_id = @column_id and is_masked = 1`
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("object_id", dataMask.ObjectId), sql.Named("column_id", dataMask.ColumnId)).
+		Scan(&column, &maskingFunction)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Data mask not found", fmt.Sprintf("Data mask with id %s doesn't exist", id))
+		}
+		return DataMask{}
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading data mask %s failed", id), err)
+		return DataMask{}
+	}
+
+	objectId, columnId := dataMask.ObjectId, dataMask.ColumnId
+	dataMask, err = parseMaskingFunction(maskingFunction)
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading data mask %s failed", id), err)
+		return DataMask{}
+	}
+
+	dataMask.Id = dataMaskFormatId(connection.ConnectionId, objectId, columnId)
+	dataMask.Connection = connection.ConnectionId
+	dataMask.Table = tableFormatId(connection.ConnectionId, objectId)
+	dataMask.Column = column
+	dataMask.ObjectId = objectId
+	dataMask.ColumnId = columnId
+
+	return
+}
+
+func UpdateDataMask(ctx context.Context, connection Connection, dataMask DataMask) {
+	column := GetColumnFromId(ctx, connection, columnFormatId(connection.ConnectionId, dataMask.ObjectId, dataMask.ColumnId), true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	alterDataMask(ctx, connection, column, dataMask)
+}
+
+func DropDataMask(ctx context.Context, connection Connection, id string) {
+
+	dataMask := GetDataMaskFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || dataMask.Id == "" {
+		return
+	}
+
+	column := GetColumnFromId(ctx, connection, columnFormatId(connection.ConnectionId, dataMask.ObjectId, dataMask.ColumnId), false)
+	if logging.HasError(ctx) || column.Id == "" {
+		return
+	}
+
+	query := fmt.Sprintf("alter table %s.%s alter column %s drop masked",
+		quoteIdentifier(column.SchemaName), quoteIdentifier(column.TableName), quoteIdentifier(column.Name))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping data mask of column %s.%s.%s failed", column.SchemaName, column.TableName, column.Name), err)
+	}
+}
+
+// Id of the masked column, to be used as scope of column level permissions
+func DataMaskColumnId(dataMask DataMask) string {
+	return columnFormatId(dataMask.Connection, dataMask.ObjectId, dataMask.ColumnId)
+}
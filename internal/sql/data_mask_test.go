@@ -0,0 +1,64 @@
+package sql
+
+import (
+	"terraform-provider-azuresql/internal/logging"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMaskingFunction(t *testing.T) {
+	assert.Equal(t, "default()", formatMaskingFunction(DataMask{Function: "default"}))
+	assert.Equal(t, "email()", formatMaskingFunction(DataMask{Function: "email"}))
+	assert.Equal(t, `partial(1, "XXXX", 2)`, formatMaskingFunction(DataMask{Function: "partial", Prefix: 1, Padding: "XXXX", Suffix: 2}))
+	assert.Equal(t, "random(1, 12.5)", formatMaskingFunction(DataMask{Function: "random", Start: 1, End: 12.5}))
+}
+
+func TestParseMaskingFunction(t *testing.T) {
+	for _, dataMask := range []DataMask{
+		{Function: "default"},
+		{Function: "email"},
+		{Function: "partial", Prefix: 1, Padding: "XX-XX", Suffix: 0},
+		{Function: "random", Start: 1, End: 100},
+	} {
+		parsed, err := parseMaskingFunction(formatMaskingFunction(dataMask))
+		assert.NoError(t, err)
+		assert.Equal(t, dataMask, parsed)
+	}
+
+	parsed, err := parseMaskingFunction(`partial(2,"XXXXXXX",0)`)
+	assert.NoError(t, err)
+	assert.Equal(t, DataMask{Function: "partial", Prefix: 2, Padding: "XXXXXXX", Suffix: 0}, parsed)
+
+	parsed, err = parseMaskingFunction("random(1.00, 12.00)")
+	assert.NoError(t, err)
+	assert.Equal(t, DataMask{Function: "random", Start: 1, End: 12}, parsed)
+
+	_, err = parseMaskingFunction("datetime(\"Y\")")
+	assert.Error(t, err)
+}
+
+func TestParseColumnPermissionId(t *testing.T) {
+	ctx := logging.GetTestContext()
+	permission := ParsePermissionId(ctx, "sqlserver::server:1433:db/permission/5/unmask/column/123/2")
+	assert.False(t, logging.HasError(ctx))
+	assert.Equal(t, "column", permission.ScopeType)
+	assert.Equal(t, int64(123), permission.ScopeId)
+	assert.Equal(t, int64(2), permission.MinorId)
+	assert.Equal(t, "sqlserver::server:1433:db/permission/5/unmask/column/123/2", permissionFormatId("sqlserver::server:1433:db", 5, "unmask", "column", 123, 2))
+
+	ctx = logging.GetTestContext()
+	permission = ParsePermissionId(ctx, "sqlserver::server:1433:db/permission/5/unmask/object/123")
+	assert.False(t, logging.HasError(ctx))
+	assert.Equal(t, int64(0), permission.MinorId)
+
+	ctx = logging.GetTestContext()
+	ParsePermissionId(ctx, "sqlserver::server:1433:db/permission/5/unmask/object/123/2")
+	assert.True(t, logging.HasError(ctx))
+
+	ctx = logging.GetTestContext()
+	column := ParseColumnId(ctx, "sqlserver::server:1433:db/column/123/2")
+	assert.False(t, logging.HasError(ctx))
+	assert.Equal(t, int64(123), column.ObjectId)
+	assert.Equal(t, int64(2), column.ColumnId)
+}
@@ -4,27 +4,140 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"terraform-provider-azuresql/internal/logging"
+	"time"
 )
 
 type Database struct {
-	Id         string
-	Connection string
-	Name       string
+	Id               string
+	Connection       string
+	Name             string
+	Edition          string
+	ServiceObjective string
+	MaxSize          string
+	ElasticPool      string
+	Collation        string
+	CatalogCollation string
 }
 
+// Settings of an Azure SQL database. Empty strings are not set.
+type DatabaseSettings struct {
+	Edition          string
+	ServiceObjective string
+	MaxSize          string
+	ElasticPool      string
+	Collation        string
+	CatalogCollation string
+	CopyOf           string
+}
+
+// Interval between checks of a pending create, copy or scale operation.
+var databaseOperationPollInterval = 10 * time.Second
+
+// Maximum time to wait for create, copy and scale operations, the same default as the azurerm provider.
+var databaseOperationTimeout = 60 * time.Minute
+
+var maxSizeRegex = regexp.MustCompile(`^(\d+) ?(MB|GB)$`)
+
 func databaseFormatId(connectionId string, name string) string {
 	return fmt.Sprintf("%s:%s", connectionId, name)
 }
 
-func CreateDatabase(ctx context.Context, connection Connection, name string) (database Database) {
+// Returns the size in bytes of a max size in the `<n> MB` or `<n> GB` format.
+func ParseMaxSize(maxSize string) (int64, error) {
+	match := maxSizeRegex.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(maxSize)))
+	if match == nil {
+		return 0, fmt.Errorf("max size %s doesn't match the format `<n> MB` or `<n> GB`", maxSize)
+	}
+
+	size, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if match[2] == "GB" {
+		return size * 1024 * 1024 * 1024, nil
+	}
+	return size * 1024 * 1024, nil
+}
+
+func formatMaxSize(bytes int64) string {
+	if bytes%(1024*1024*1024) == 0 {
+		return fmt.Sprintf("%d GB", bytes/(1024*1024*1024))
+	}
+	return fmt.Sprintf("%d MB", bytes/(1024*1024))
+}
+
+func IsMaxSizeEquivalent(a string, b string) bool {
+	sizeA, errA := ParseMaxSize(a)
+	sizeB, errB := ParseMaxSize(b)
+	return errA == nil && errB == nil && sizeA == sizeB
+}
 
-	query := fmt.Sprintf("create database [%s]", name)
+func formatMaxSizeOption(maxSize string) string {
+	size, err := ParseMaxSize(maxSize)
+	if err != nil {
+		return maxSize
+	}
+	return strings.ReplaceAll(formatMaxSize(size), " ", "")
+}
+
+// Service level options shared by `create database` and `alter database ... modify`.
+func buildDatabaseServiceOptions(settings DatabaseSettings) string {
+	var options []string
+	if settings.Edition != "" {
+		options = append(options, "edition = "+quoteString(settings.Edition))
+	}
+	if settings.ElasticPool != "" {
+		options = append(options, fmt.Sprintf("service_objective = elastic_pool(name = %s)", quoteIdentifier(settings.ElasticPool)))
+	} else if settings.ServiceObjective != "" {
+		options = append(options, "service_objective = "+quoteString(settings.ServiceObjective))
+	}
+	if settings.MaxSize != "" {
+		options = append(options, "maxsize = "+formatMaxSizeOption(settings.MaxSize))
+	}
+	return strings.Join(options, ", ")
+}
+
+func buildCreateDatabaseQuery(name string, settings DatabaseSettings) string {
+	query := "create database " + quoteIdentifier(name)
+
+	if settings.CopyOf != "" {
+		var source []string
+		for _, part := range strings.Split(settings.CopyOf, ".") {
+			source = append(source, quoteIdentifier(part))
+		}
+		query += " as copy of " + strings.Join(source, ".")
+	} else if settings.Collation != "" {
+		query += " collate " + settings.Collation
+	}
+
+	if options := buildDatabaseServiceOptions(settings); options != "" {
+		query += " (" + options + ")"
+	}
+
+	if settings.CopyOf == "" && settings.CatalogCollation != "" {
+		query += " with catalog_collation = " + settings.CatalogCollation
+	}
+
+	return query
+}
+
+func CreateDatabase(ctx context.Context, connection Connection, name string, settings DatabaseSettings) (database Database) {
+
+	query := buildCreateDatabaseQuery(name, settings)
 
 	_, err := connection.Connection.ExecContext(ctx, query)
 
 	logging.AddError(ctx, fmt.Sprintf("Databse creation failed for database %s", name), err)
 
+	if !logging.HasError(ctx) && connection.Provider == "sqlserver" {
+		waitForDatabaseOperations(ctx, connection, name)
+	}
+
 	database = GetDatabaseFromName(ctx, connection, name)
 	if database.Id == "" && !logging.HasError(ctx) {
 		logging.AddError(ctx, "Unable to read newly created database", fmt.Sprintf("Unable to read dabase %s after creation.", name))
@@ -36,13 +149,17 @@ func CreateDatabase(ctx context.Context, connection Connection, name string) (da
 func GetDatabaseFromName(ctx context.Context, connection Connection, name string) (database Database) {
 
 	var id int64
+	var collation, catalogCollation sql.NullString
 
-	query := "select database_id from sys.databases where name = @name"
+	query := "select database_id, collation_name, catalog_collation_type_desc from sys.databases where name = @name"
+	if connection.Provider != "sqlserver" {
+		query = "select database_id, collation_name, null from sys.databases where name = @name"
+	}
 
 	err := (connection.
 		Connection.
 		QueryRowContext(ctx, query, sql.Named("name", name)).
-		Scan(&id))
+		Scan(&id, &collation, &catalogCollation))
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -53,41 +170,212 @@ func GetDatabaseFromName(ctx context.Context, connection Connection, name string
 		return
 	}
 
-	return Database{
-		Id:         databaseFormatId(connection.ConnectionId, name),
-		Connection: connection.ConnectionId,
-		Name:       name,
+	database = Database{
+		Id:               databaseFormatId(connection.ConnectionId, name),
+		Connection:       connection.ConnectionId,
+		Name:             name,
+		Collation:        collation.String,
+		CatalogCollation: catalogCollation.String,
+	}
+
+	if connection.Provider != "sqlserver" {
+		return
+	}
+
+	var elasticPool sql.NullString
+	var maxSize sql.NullInt64
+
+	query = `
+		select dso.edition, dso.service_objective, dso.elastic_pool_name, convert(bigint, databasepropertyex(d.name, 'MaxSizeInBytes'))
+		from sys.databases d
+		inner join sys.database_service_objectives dso on dso.database_id = d.database_id
+		where d.database_id = @id`
+
+	err = connection.Connection.QueryRowContext(ctx, query, sql.Named("id", id)).
+		Scan(&database.Edition, &database.ServiceObjective, &elasticPool, &maxSize)
+	if err != nil && err != sql.ErrNoRows {
+		logging.AddError(ctx, fmt.Sprintf("Reading service objective of database %s failed", name), err)
+		return
+	}
+
+	database.ElasticPool = elasticPool.String
+	if maxSize.Valid {
+		database.MaxSize = formatMaxSize(maxSize.Int64)
 	}
 
+	return
 }
 
-func DropDatabase(ctx context.Context, connection Connection, name string) {
+// Scales the database to the edition, service objective, elastic pool and max size in settings.
+func UpdateDatabase(ctx context.Context, connection Connection, name string, settings DatabaseSettings) {
 
-	// check if database exists
-	database := GetDatabaseFromName(ctx, connection, name)
-	if logging.HasError(ctx) || database.Id == "" {
+	options := buildDatabaseServiceOptions(settings)
+	if options == "" {
+		return
+	}
+
+	query := fmt.Sprintf("alter database %s modify (%s)", quoteIdentifier(name), options)
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Modifying database %s failed", name), err)
 		return
 	}
 
-	// drop all connections from the database before deletion
-	var err error
+	waitForDatabaseOperations(ctx, connection, name)
+}
+
+// Create, copy and scale operations continue asynchronously after the statement returns.
+// Wait until no operation is pending and the database is online, or the timeout expires.
+func waitForDatabaseOperations(ctx context.Context, connection Connection, name string) {
+	timeout := time.After(databaseOperationTimeout)
+
+	query := `
+		select
+			(select count(*) from sys.dm_operation_status where major_resource_id = @name and state in (0, 1)),
+			(select state_desc from sys.databases where name = @name)`
+
+	for {
+		var pending int64
+		var state sql.NullString
+
+		err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name)).Scan(&pending, &state)
+		if err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Reading operation status of database %s failed", name), err)
+			return
+		}
+
+		if pending == 0 && state.String != "COPYING" {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			logging.AddError(ctx, fmt.Sprintf("Waiting for operation on database %s failed", name), ctx.Err())
+			return
+		case <-timeout:
+			logging.AddError(ctx, fmt.Sprintf("Waiting for operation on database %s failed", name),
+				fmt.Sprintf("The operation didn't complete within %s, check sys.dm_operation_status for its progress.", databaseOperationTimeout))
+			return
+		case <-time.After(databaseOperationPollInterval):
+		}
+	}
+
+	var operation, state, errorDesc sql.NullString
+	query = `
+		select top 1 operation, state_desc, error_desc
+		from sys.dm_operation_status
+		where major_resource_id = @name
+		order by start_time desc`
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name)).Scan(&operation, &state, &errorDesc)
+	switch {
+	case err == sql.ErrNoRows:
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading operation status of database %s failed", name), err)
+	case state.String == "FAILED":
+		logging.AddError(ctx, fmt.Sprintf("Operation %s on database %s failed", operation.String, name), errorDesc.String)
+	}
+}
+
+// Number of sessions connected to the database, excluding the current session.
+func GetDatabaseSessionCount(ctx context.Context, connection Connection, name string) (count int64) {
+	// sessions opened by this provider process, e.g. cached connections to the database, aren't counted
+	query := `
+		select count(*)
+		from sys.dm_exec_sessions s
+		where s.database_id = db_id(@name) and s.session_id <> @@spid
+			and not exists (
+				select 1 from sys.dm_exec_sessions own
+				where own.session_id = @@spid and own.host_name = s.host_name and own.host_process_id = s.host_process_id)`
+
+	if err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name)).Scan(&count); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading sessions of database %s failed", name), err)
+	}
+	return
+}
+
+// Number of user tables in the connected database.
+func GetUserTableCount(ctx context.Context, connection Connection) (count int64) {
+	query := "select count(*) from sys.tables where is_ms_shipped = 0"
+
+	if err := connection.Connection.QueryRowContext(ctx, query).Scan(&count); err != nil {
+		logging.AddError(ctx, "Reading user tables failed", err)
+	}
+	return
+}
+
+// Azure SQL Database reports engine edition 5, SQL Server and Azure SQL Managed Instance report other editions.
+func isAzureSQLDatabase(ctx context.Context, connection Connection) bool {
+	var engineEdition int64
+	if err := connection.Connection.QueryRowContext(ctx, "select convert(int, serverproperty('EngineEdition'))").Scan(&engineEdition); err != nil {
+		logging.AddError(ctx, "Reading engine edition failed", err)
+		return false
+	}
+	return engineEdition == 5
+}
+
+func killDatabaseSessions(ctx context.Context, connection Connection, name string) {
 	query := fmt.Sprintf(`
 		DECLARE @kill varchar(8000) = '';  
 		SELECT @kill = @kill + 'kill ' + CONVERT(varchar(5), session_id) + ';'  
 		FROM sys.dm_exec_sessions
-		WHERE database_id  = db_id('%s')
+		WHERE database_id  = db_id(%s) and session_id <> @@spid
 		
 		exec(@kill)
-	`, name)
-	_, err = connection.Connection.ExecContext(ctx, query)
+	`, quoteString(name))
 
-	if err != nil {
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
 		logging.AddError(ctx, fmt.Sprintf("Closing connections from database %s failed", name), err)
+	}
+}
+
+// OnDestroy determines how sessions connected to the database are handled before it is dropped:
+//   - fail_if_sessions: the drop fails when sessions are connected
+//   - kill_sessions: connected sessions are killed
+//   - set_single_user: the database is set to single user, rolling back open transactions (not in Azure SQL Database)
+func DropDatabase(ctx context.Context, connection Connection, name string, onDestroy string) {
+
+	// check if database exists
+	database := GetDatabaseFromName(ctx, connection, name)
+	if logging.HasError(ctx) || database.Id == "" {
 		return
 	}
 
-	query = fmt.Sprintf("drop database if exists [%s]", name)
-	_, err = connection.Connection.ExecContext(ctx, query)
+	switch onDestroy {
+	case "fail_if_sessions":
+		sessions := GetDatabaseSessionCount(ctx, connection, name)
+		if logging.HasError(ctx) {
+			return
+		}
+		if sessions > 0 {
+			logging.AddError(ctx, "Database in use",
+				fmt.Sprintf("Database %s has %d connected sessions. Close the sessions or set `on_destroy` to `kill_sessions` or `set_single_user` to drop the database.", name, sessions))
+			return
+		}
+	case "set_single_user":
+		azureSQLDatabase := isAzureSQLDatabase(ctx, connection)
+		if logging.HasError(ctx) {
+			return
+		}
+		if azureSQLDatabase {
+			logging.AddError(ctx, "Invalid config",
+				fmt.Sprintf("`on_destroy = \"set_single_user\"` is only supported in SQL Server and Azure SQL Managed Instance. Use `kill_sessions` to drop database %s.", name))
+			return
+		}
+		query := fmt.Sprintf("alter database %s set single_user with rollback immediate", quoteIdentifier(name))
+		if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Setting database %s to single user failed", name), err)
+			return
+		}
+	default:
+		killDatabaseSessions(ctx, connection, name)
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+
+	query := fmt.Sprintf("drop database if exists %s", quoteIdentifier(name))
+	_, err := connection.Connection.ExecContext(ctx, query)
 
 	if err != nil {
 		logging.AddError(ctx, fmt.Sprintf("Dropping database %s failed", name), err)
@@ -0,0 +1,140 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+// Database scoped configurations and database level options of the connected database.
+type DatabaseOptions struct {
+	Id                          string
+	Connection                  string
+	MaxDop                      int64
+	LegacyCardinalityEstimation bool
+	QueryOptimizerHotfixes      bool
+	CompatibilityLevel          int64
+	ReadCommittedSnapshot       bool
+	QueryStoreMode              string
+	Owner                       string
+}
+
+func databaseOptionsFormatId(connectionId string) string {
+	return fmt.Sprintf("%s/databaseoptions", connectionId)
+}
+
+func ParseDatabaseOptionsId(ctx context.Context, id string) (options DatabaseOptions) {
+	if !strings.HasSuffix(id, "/databaseoptions") {
+		logging.AddError(ctx, "ID format error", "id doesn't end in /databaseoptions")
+		return
+	}
+
+	options.Id = id
+	options.Connection = strings.TrimSuffix(id, "/databaseoptions")
+
+	return
+}
+
+func GetDatabaseOptions(ctx context.Context, connection Connection) (options DatabaseOptions) {
+	var maxDop sql.NullInt64
+	var legacyCardinalityEstimation, queryOptimizerHotfixes sql.NullBool
+	var owner, queryStoreMode sql.NullString
+
+	query := `
+		select
+			(select convert(int, value) from sys.database_scoped_configurations where name = 'MAXDOP'),
+			(select convert(bit, value) from sys.database_scoped_configurations where name = 'LEGACY_CARDINALITY_ESTIMATION'),
+			(select convert(bit, value) from sys.database_scoped_configurations where name = 'QUERY_OPTIMIZER_HOTFIXES'),
+			d.compatibility_level,
+			d.is_read_committed_snapshot_on,
+			(select desired_state_desc from sys.database_query_store_options),
+			suser_sname(d.owner_sid)
+		from sys.databases d
+		where d.database_id = db_id()`
+
+	err := connection.Connection.QueryRowContext(ctx, query).Scan(
+		&maxDop, &legacyCardinalityEstimation, &queryOptimizerHotfixes,
+		&options.CompatibilityLevel, &options.ReadCommittedSnapshot, &queryStoreMode, &owner)
+	if err != nil {
+		logging.AddError(ctx, "Reading database options failed", err)
+		return
+	}
+
+	options.Id = databaseOptionsFormatId(connection.ConnectionId)
+	options.Connection = connection.ConnectionId
+	options.MaxDop = maxDop.Int64
+	options.LegacyCardinalityEstimation = legacyCardinalityEstimation.Bool
+	options.QueryOptimizerHotfixes = queryOptimizerHotfixes.Bool
+	options.QueryStoreMode = queryStoreMode.String
+	options.Owner = owner.String
+
+	return
+}
+
+func onOff(value bool) string {
+	if value {
+		return "on"
+	}
+	return "off"
+}
+
+func SetMaxDop(ctx context.Context, connection Connection, maxDop int64) {
+	query := fmt.Sprintf("alter database scoped configuration set maxdop = %d", maxDop)
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, "Setting MAXDOP failed", err)
+	}
+}
+
+func SetLegacyCardinalityEstimation(ctx context.Context, connection Connection, enabled bool) {
+	query := fmt.Sprintf("alter database scoped configuration set legacy_cardinality_estimation = %s", onOff(enabled))
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, "Setting LEGACY_CARDINALITY_ESTIMATION failed", err)
+	}
+}
+
+func SetQueryOptimizerHotfixes(ctx context.Context, connection Connection, enabled bool) {
+	query := fmt.Sprintf("alter database scoped configuration set query_optimizer_hotfixes = %s", onOff(enabled))
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, "Setting QUERY_OPTIMIZER_HOTFIXES failed", err)
+	}
+}
+
+func SetCompatibilityLevel(ctx context.Context, connection Connection, level int64) {
+	query := fmt.Sprintf("alter database current set compatibility_level = %d", level)
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, "Setting compatibility level failed", err)
+	}
+}
+
+func SetReadCommittedSnapshot(ctx context.Context, connection Connection, enabled bool) {
+	query := fmt.Sprintf("alter database current set read_committed_snapshot %s", onOff(enabled))
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, "Setting READ_COMMITTED_SNAPSHOT failed", err)
+	}
+}
+
+// Mode is one of OFF, READ_ONLY or READ_WRITE
+func SetQueryStoreMode(ctx context.Context, connection Connection, mode string) {
+	query := "alter database current set query_store = off"
+	if mode != "OFF" {
+		query = fmt.Sprintf("alter database current set query_store = on (operation_mode = %s)", mode)
+	}
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, "Setting query store mode failed", err)
+	}
+}
+
+func SetDatabaseOwner(ctx context.Context, connection Connection, owner string) {
+	var name string
+	if err := connection.Connection.QueryRowContext(ctx, "select db_name()").Scan(&name); err != nil {
+		logging.AddError(ctx, "Reading database name failed", err)
+		return
+	}
+
+	query := fmt.Sprintf("alter authorization on database::%s to %s", quoteIdentifier(name), quoteIdentifier(owner))
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Changing owner of database %s to %s failed", name, owner), err)
+	}
+}
@@ -50,16 +50,17 @@ func ParseDatabaseScopedCredentialId(ctx context.Context, id string) (databaseSc
 	return
 }
 
-func CreateDatabaseScopedCredential(ctx context.Context, connection Connection, name string, identity string, secret string) (databaseScopedCredential DatabaseScopedCredential) {
-
-	var query string
-	if secret == "" {
-		query = fmt.Sprintf("create database scoped credential %s with identity = '%s'", name, identity)
-	} else {
-		query = fmt.Sprintf("create database scoped credential %s with identity = '%s', secret='%s'", name, identity, secret)
+func buildDatabaseScopedCredentialQuery(verb string, name string, identity string, secret string) string {
+	query := fmt.Sprintf("%s database scoped credential %s with identity = %s", verb, quoteIdentifier(name), quoteString(canonicalCredentialIdentity(identity)))
+	if secret != "" {
+		query += fmt.Sprintf(", secret = %s", quoteString(secret))
 	}
+	return query
+}
+
+func CreateDatabaseScopedCredential(ctx context.Context, connection Connection, name string, identity string, secret string) (databaseScopedCredential DatabaseScopedCredential) {
 
-	_, err := connection.Connection.ExecContext(ctx, query)
+	_, err := connection.Connection.ExecContext(ctx, buildDatabaseScopedCredentialQuery("create", name, identity, secret))
 	logging.AddError(ctx, "Creation of database scoped credential failed", err)
 
 	// set requiresExist to false in order to specify a custom error message
@@ -72,16 +73,11 @@ func CreateDatabaseScopedCredential(ctx context.Context, connection Connection,
 	return
 }
 
+// Change the identity and secret of a database scoped credential in place.
+// The secret is always replaced, an empty secret removes it.
 func AlterDatabaseScopedCredential(ctx context.Context, connection Connection, name string, identity string, secret string) (databaseScopedCredential DatabaseScopedCredential) {
 
-	var query string
-	if secret == "" {
-		query = fmt.Sprintf("alter database scoped credential %s with identity = '%s'", name, identity)
-	} else {
-		query = fmt.Sprintf("alter database scoped credential %s with identity = '%s', secret='%s'", name, identity, secret)
-	}
-
-	_, err := connection.Connection.ExecContext(ctx, query)
+	_, err := connection.Connection.ExecContext(ctx, buildDatabaseScopedCredentialQuery("alter", name, identity, secret))
 	logging.AddError(ctx, "Updating database scoped credential failed", err)
 
 	// set requiresExist to false in order to specify a custom error message
@@ -94,6 +90,34 @@ func AlterDatabaseScopedCredential(ctx context.Context, connection Connection, n
 	return
 }
 
+// Identities with a special meaning. They are matched case sensitive by Synapse.
+var specialCredentialIdentities = []string{"Managed Identity", "SHARED ACCESS SIGNATURE"}
+
+// Spelling of an identity as expected by Synapse, special identities are recognized regardless of case.
+func canonicalCredentialIdentity(identity string) string {
+	for _, special := range specialCredentialIdentities {
+		if strings.EqualFold(identity, special) {
+			return special
+		}
+	}
+	return identity
+}
+
+// Validate the combination of identity and secret, returns an empty string when valid.
+func ValidateDatabaseScopedCredential(identity string, secret string) string {
+	identity = canonicalCredentialIdentity(identity)
+
+	if identity == "Managed Identity" && secret != "" {
+		return "a secret can't be specified for the `Managed Identity` identity"
+	}
+
+	if identity == "SHARED ACCESS SIGNATURE" && strings.HasPrefix(secret, "?") {
+		return "the shared access signature should be specified without the leading `?`"
+	}
+
+	return ""
+}
+
 func GetDatabaseScopedCredentialFromName(ctx context.Context, connection Connection, name string, requiresExist bool) (databaseScopedCredential DatabaseScopedCredential) {
 	var credentialId int64
 	var identity string
@@ -182,7 +206,7 @@ func DropDatabaseScopedCredential(ctx context.Context, connection Connection, id
 	}
 
 	var err error
-	_, err = connection.Connection.ExecContext(ctx, fmt.Sprintf("drop database scoped credential %s", databaseScopedCredential.Name))
+	_, err = connection.Connection.ExecContext(ctx, fmt.Sprintf("drop database scoped credential %s", quoteIdentifier(databaseScopedCredential.Name)))
 
 	if err != nil {
 		logging.AddError(ctx, "Dropping database scoped credential failed", err)
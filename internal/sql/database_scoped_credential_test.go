@@ -0,0 +1,42 @@
+package sql
+
+import "testing"
+
+func TestBuildDatabaseScopedCredentialQuery(t *testing.T) {
+	query := buildDatabaseScopedCredentialQuery("alter", "my]credential", "SHARED ACCESS SIGNATURE", "it's secret")
+	expected := "alter database scoped credential [my]]credential] with identity = 'SHARED ACCESS SIGNATURE', secret = 'it''s secret'"
+	if query != expected {
+		t.Errorf("Unexpected query %s", query)
+	}
+
+	query = buildDatabaseScopedCredentialQuery("create", "credential", "managed identity", "")
+	expected = "create database scoped credential [credential] with identity = 'Managed Identity'"
+	if query != expected {
+		t.Errorf("Unexpected query %s", query)
+	}
+}
+
+func TestValidateDatabaseScopedCredential(t *testing.T) {
+	cases := []struct {
+		identity string
+		secret   string
+		valid    bool
+	}{
+		{"SHARED ACCESS SIGNATURE", "sv=2022-11-02&sig=abc", true},
+		{"SHARED ACCESS SIGNATURE", "?sv=2022-11-02&sig=abc", false},
+		{"Shared Access Signature", "sv=2022-11-02&sig=abc", true},
+		{"shared access signature", "?sv=2022-11-02&sig=abc", false},
+		{"Managed Identity", "", true},
+		{"managed identity", "", true},
+		{"managed identity", "secret", false},
+		{"Managed Identity", "secret", false},
+		{"myuser", "secret", true},
+	}
+
+	for _, c := range cases {
+		message := ValidateDatabaseScopedCredential(c.identity, c.secret)
+		if (message == "") != c.valid {
+			t.Errorf("Unexpected validation result for identity %s: %q", c.identity, message)
+		}
+	}
+}
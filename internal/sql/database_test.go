@@ -0,0 +1,51 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMaxSize(t *testing.T) {
+	size, err := ParseMaxSize("250 GB")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(250*1024*1024*1024), size)
+
+	size, err = ParseMaxSize("500MB")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(500*1024*1024), size)
+
+	_, err = ParseMaxSize("1 TB")
+	assert.NotNil(t, err)
+
+	assert.True(t, IsMaxSizeEquivalent("1024 MB", "1 GB"))
+	assert.False(t, IsMaxSizeEquivalent("100 MB", "1 GB"))
+	assert.Equal(t, "2 GB", formatMaxSize(2*1024*1024*1024))
+	assert.Equal(t, "100 MB", formatMaxSize(100*1024*1024))
+}
+
+func TestBuildCreateDatabaseQuery(t *testing.T) {
+	query := buildCreateDatabaseQuery("sales", DatabaseSettings{})
+	assert.Equal(t, "create database [sales]", query)
+
+	query = buildCreateDatabaseQuery("sales", DatabaseSettings{
+		Edition:          "Standard",
+		ServiceObjective: "S1",
+		MaxSize:          "250 GB",
+		Collation:        "Latin1_General_100_CI_AS_SC_UTF8",
+		CatalogCollation: "DATABASE_DEFAULT",
+	})
+	assert.Equal(t, "create database [sales] collate Latin1_General_100_CI_AS_SC_UTF8 (edition = 'Standard', service_objective = 'S1', maxsize = 250GB) with catalog_collation = DATABASE_DEFAULT", query)
+
+	query = buildCreateDatabaseQuery("sales", DatabaseSettings{ElasticPool: "pool", ServiceObjective: "S1"})
+	assert.Equal(t, "create database [sales] (service_objective = elastic_pool(name = [pool]))", query)
+
+	query = buildCreateDatabaseQuery("sales_copy", DatabaseSettings{CopyOf: "otherserver.sales", ServiceObjective: "S0"})
+	assert.Equal(t, "create database [sales_copy] as copy of [otherserver].[sales] (service_objective = 'S0')", query)
+}
+
+func TestBuildDatabaseServiceOptions(t *testing.T) {
+	assert.Equal(t, "", buildDatabaseServiceOptions(DatabaseSettings{}))
+	assert.Equal(t, "edition = 'Premium', service_objective = 'P1', maxsize = 500MB",
+		buildDatabaseServiceOptions(DatabaseSettings{Edition: "Premium", ServiceObjective: "P1", MaxSize: "500 MB"}))
+}
@@ -3,9 +3,106 @@ package sql
 import (
 	"context"
 	"fmt"
+	"strings"
 	"terraform-provider-azuresql/internal/logging"
 )
 
+// `GO` is not a T-SQL statement but a batch separator interpreted by client tools
+// such as sqlcmd and SSMS. It has to be on a line of its own.
+func isBatchSeparator(line string) bool {
+	line = strings.TrimSpace(line)
+	line = strings.TrimSpace(strings.TrimSuffix(line, ";"))
+	return strings.EqualFold(line, "GO")
+}
+
+// Split a script into the batches separated by `GO`. Empty batches are dropped.
+// Lines within string literals, quoted identifiers and comments are never separators.
+func SplitBatches(script string) (batches []string) {
+	var batch strings.Builder
+	var quote byte     // closing character of the current literal or identifier
+	var blockDepth int // block comments can be nested in T-SQL
+	var lineComment bool
+	lineStart := 0
+
+	flush := func(end int) {
+		if strings.TrimSpace(batch.String()) != "" {
+			batches = append(batches, batch.String())
+		}
+		batch.Reset()
+		lineStart = end
+	}
+
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+
+		switch {
+		case lineComment:
+			if c == '\n' {
+				lineComment = false
+			}
+		case blockDepth > 0:
+			if c == '*' && i+1 < len(script) && script[i+1] == '/' {
+				blockDepth--
+				batch.WriteByte(c)
+				i++
+				c = script[i]
+			} else if c == '/' && i+1 < len(script) && script[i+1] == '*' {
+				blockDepth++
+				batch.WriteByte(c)
+				i++
+				c = script[i]
+			}
+		case quote != 0:
+			if c == quote {
+				// a doubled closing character is an escaped one
+				if i+1 < len(script) && script[i+1] == quote {
+					batch.WriteByte(c)
+					i++
+				} else {
+					quote = 0
+				}
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			quote = ']'
+		case c == '-' && i+1 < len(script) && script[i+1] == '-':
+			lineComment = true
+		case c == '/' && i+1 < len(script) && script[i+1] == '*':
+			blockDepth++
+			batch.WriteByte(c)
+			i++
+			c = script[i]
+		}
+
+		if c == '\n' && quote == 0 && blockDepth == 0 {
+			// the line that just ended started outside of any literal or comment
+			line := script[lineStart:i]
+			if isBatchSeparator(line) {
+				content := batch.String()
+				batch.Reset()
+				batch.WriteString(content[:len(content)-len(line)])
+				flush(i + 1)
+				continue
+			}
+			batch.WriteByte(c)
+			lineStart = i + 1
+			continue
+		}
+
+		batch.WriteByte(c)
+	}
+
+	if quote == 0 && blockDepth == 0 && isBatchSeparator(script[lineStart:]) {
+		content := batch.String()
+		batch.Reset()
+		batch.WriteString(content[:len(content)-len(script[lineStart:])])
+	}
+	flush(len(script))
+
+	return batches
+}
+
 func Execute(ctx context.Context, connection Connection, sql string) {
 
 	_, err := connection.Connection.ExecContext(ctx, sql)
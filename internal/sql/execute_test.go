@@ -0,0 +1,41 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitBatches(t *testing.T) {
+	script := "create table t (c int)\nGO\n\ninsert into t values (1)\n  go ;\nGO\nselect 'GO' as go_column\n"
+
+	batches := SplitBatches(script)
+	if len(batches) != 3 {
+		t.Fatalf("Expected 3 batches, got %d: %q", len(batches), batches)
+	}
+
+	if batches[2] != "select 'GO' as go_column\n" {
+		t.Errorf("Separator inside a statement should not split the batch, got %q", batches[2])
+	}
+}
+
+func TestSplitBatchesIgnoresLiteralsAndComments(t *testing.T) {
+	tests := map[string]string{
+		"string literal":       "insert into t values ('a\nGO\nb')\n",
+		"escaped quote":        "insert into t values ('it''s\nGO\n')\n",
+		"quoted identifier":    "create table [a\nGO\nb] (c int)\n",
+		"block comment":        "/* setup\nGO\n*/\nselect 1\n",
+		"nested block comment": "/* outer /* inner */\nGO\n*/\nselect 1\n",
+		"line comment":         "select 1 -- GO\n",
+	}
+
+	for name, script := range tests {
+		assert.Equal(t, []string{script}, SplitBatches(script), name)
+	}
+}
+
+func TestSplitBatchesAfterComment(t *testing.T) {
+	script := "/* first\nbatch */\nselect 1\nGO\nselect 2 -- second\ngo"
+
+	assert.Equal(t, []string{"/* first\nbatch */\nselect 1\n", "select 2 -- second\n"}, SplitBatches(script))
+}
@@ -10,12 +10,17 @@ import (
 )
 
 type ExternalDataSource struct {
-	Id           string
-	Connection   string
-	Name         string
-	DataSourceId int64
-	Credential   string
-	Location     string
+	Id                string
+	Connection        string
+	Name              string
+	DataSourceId      int64
+	Credential        string
+	Location          string
+	Type              string
+	DatabaseName      sql.NullString
+	ShardMapName      sql.NullString
+	Pushdown          sql.NullBool
+	ConnectionOptions sql.NullString
 }
 
 func externalDataSourceFormatId(connectionId string, dataSourceId int64) string {
@@ -59,33 +64,54 @@ func ParseExternalDataSourceId(ctx context.Context, id string) (externalDataSour
 	return
 }
 
-func CreateExternalDataSource(ctx context.Context, connection Connection, name string, location string, credential string) (externalDataSource ExternalDataSource) {
+func buildExternalDataSourceQuery(name string, credentialName string, externalDataSource ExternalDataSource) string {
+	options := []string{fmt.Sprintf("location = %s", quoteString(externalDataSource.Location))}
 
-	var credential_arg, type_arg string
-	if credential != "" {
-		databaseScopedCredential := GetDatabaseScopedCredentialFromId(ctx, connection, credential, true)
+	if credentialName != "" {
+		options = append(options, fmt.Sprintf("credential = %s", quoteIdentifier(credentialName)))
+	}
+	if externalDataSource.Type != "" {
+		options = append(options, fmt.Sprintf("type = %s", externalDataSource.Type))
+	}
+	if externalDataSource.DatabaseName.Valid {
+		options = append(options, fmt.Sprintf("database_name = %s", quoteString(externalDataSource.DatabaseName.String)))
+	}
+	if externalDataSource.ShardMapName.Valid {
+		options = append(options, fmt.Sprintf("shard_map_name = %s", quoteString(externalDataSource.ShardMapName.String)))
+	}
+	if externalDataSource.Pushdown.Valid {
+		if externalDataSource.Pushdown.Bool {
+			options = append(options, "pushdown = on")
+		} else {
+			options = append(options, "pushdown = off")
+		}
+	}
+	if externalDataSource.ConnectionOptions.Valid {
+		options = append(options, fmt.Sprintf("connection_options = %s", quoteString(externalDataSource.ConnectionOptions.String)))
+	}
+
+	return fmt.Sprintf("create external data source %s with (%s)", quoteIdentifier(name), strings.Join(options, ", "))
+}
+
+func CreateExternalDataSource(ctx context.Context, connection Connection, name string, externalDataSource ExternalDataSource) ExternalDataSource {
+
+	var credentialName string
+	if externalDataSource.Credential != "" {
+		databaseScopedCredential := GetDatabaseScopedCredentialFromId(ctx, connection, externalDataSource.Credential, true)
 
 		if logging.HasError(ctx) {
-			return
+			return ExternalDataSource{}
 		}
 
-		credential_arg = fmt.Sprintf(", credential = %s", databaseScopedCredential.Name)
-	} else {
-		credential_arg = ""
+		credentialName = databaseScopedCredential.Name
 	}
 
-	if connection.Provider == "synapse" || connection.Provider == "synapsededicated" {
-		type_arg = ""
-	} else {
-		type_arg = ", type = BLOB_STORAGE"
+	// Synapse doesn't support the type argument for blob storage
+	if externalDataSource.Type == "" && connection.Provider != "synapse" && connection.Provider != "synapsededicated" {
+		externalDataSource.Type = "BLOB_STORAGE"
 	}
 
-	query := fmt.Sprintf(`
-		create external data source [%s]
-		with (location = '%s' %s %s)
-		`, name, location, credential_arg, type_arg)
-
-	_, err := connection.Connection.ExecContext(ctx, query)
+	_, err := connection.Connection.ExecContext(ctx, buildExternalDataSourceQuery(name, credentialName, externalDataSource))
 
 	logging.AddError(ctx, fmt.Sprintf("External data source creation failed for %s", name), err)
 
@@ -100,18 +126,17 @@ func CreateExternalDataSource(ctx context.Context, connection Connection, name s
 
 func GetExternalDataSourceFromName(ctx context.Context, connection Connection, name string, requiresExist bool) (externalDataSource ExternalDataSource) {
 
-	var dataSourceId, credentialId int64
-	var location string
+	var dataSourceId int64
 
 	query := `
-		select data_source_id, credential_id, location
+		select data_source_id
 		from sys.external_data_sources
 		where name = @name`
 
 	err := (connection.
 		Connection.
 		QueryRowContext(ctx, query, sql.Named("name", name)).
-		Scan(&dataSourceId, &credentialId, &location))
+		Scan(&dataSourceId))
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -124,31 +149,30 @@ func GetExternalDataSourceFromName(ctx context.Context, connection Connection, n
 		return
 	}
 
-	return ExternalDataSource{
-		Id:           externalDataSourceFormatId(connection.ConnectionId, dataSourceId),
-		Connection:   connection.ConnectionId,
-		Name:         name,
-		Location:     location,
-		Credential:   externalDataSourceFormatCredential(connection.ConnectionId, credentialId),
-		DataSourceId: dataSourceId,
-	}
-
+	return GetExternalDataSourceFromDataSourceId(ctx, connection, dataSourceId, requiresExist)
 }
 
 func GetExternalDataSourceFromDataSourceId(ctx context.Context, connection Connection, dataSourceId int64, requiresExist bool) (externalDataSource ExternalDataSource) {
 
 	var credentialId int64
-	var location, name string
+	var pushdown sql.NullString
 
-	query := `
-		select name, credential_id, location
+	// pushdown and connection_options only exist in SQL databases
+	sqlServerColumns := "null, null"
+	if connection.Provider == "sqlserver" {
+		sqlServerColumns = "pushdown, connection_options"
+	}
+
+	query := fmt.Sprintf(`
+		select name, credential_id, location, type_desc, database_name, shard_map_name, %s
 		from sys.external_data_sources
-		where data_source_id = @id`
+		where data_source_id = @id`, sqlServerColumns)
 
 	err := (connection.
 		Connection.
 		QueryRowContext(ctx, query, sql.Named("id", dataSourceId)).
-		Scan(&name, &credentialId, &location))
+		Scan(&externalDataSource.Name, &credentialId, &externalDataSource.Location, &externalDataSource.Type,
+			&externalDataSource.DatabaseName, &externalDataSource.ShardMapName, &pushdown, &externalDataSource.ConnectionOptions))
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -157,18 +181,20 @@ func GetExternalDataSourceFromDataSourceId(ctx context.Context, connection Conne
 		}
 		return
 	case err != nil:
-		logging.AddError(ctx, fmt.Sprintf("Reading external data source %s failed", name), err)
+		logging.AddError(ctx, fmt.Sprintf("Reading external data source %d failed", dataSourceId), err)
 		return
 	}
 
-	return ExternalDataSource{
-		Id:           externalDataSourceFormatId(connection.ConnectionId, dataSourceId),
-		Connection:   connection.ConnectionId,
-		Name:         name,
-		Location:     location,
-		Credential:   externalDataSourceFormatCredential(connection.ConnectionId, credentialId),
-		DataSourceId: dataSourceId,
+	externalDataSource.Id = externalDataSourceFormatId(connection.ConnectionId, dataSourceId)
+	externalDataSource.Connection = connection.ConnectionId
+	externalDataSource.Credential = externalDataSourceFormatCredential(connection.ConnectionId, credentialId)
+	externalDataSource.DataSourceId = dataSourceId
+
+	if pushdown.Valid {
+		externalDataSource.Pushdown = sql.NullBool{Bool: strings.EqualFold(pushdown.String, "on"), Valid: true}
 	}
+
+	return externalDataSource
 }
 
 // Get user from the azuresql terraform id
@@ -189,6 +215,30 @@ func GetExternalDataSourceFromId(ctx context.Context, connection Connection, id
 	return externalDataSource
 }
 
+// Change the location and credential of an external data source in place.
+func UpdateExternalDataSource(ctx context.Context, connection Connection, id string, location string, credential string) {
+	externalDataSource := GetExternalDataSourceFromId(ctx, connection, id, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	options := []string{fmt.Sprintf("location = %s", quoteString(location))}
+
+	if credential != "" {
+		databaseScopedCredential := GetDatabaseScopedCredentialFromId(ctx, connection, credential, true)
+		if logging.HasError(ctx) {
+			return
+		}
+		options = append(options, fmt.Sprintf("credential = %s", quoteIdentifier(databaseScopedCredential.Name)))
+	}
+
+	query := fmt.Sprintf("alter external data source %s set %s", quoteIdentifier(externalDataSource.Name), strings.Join(options, ", "))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Updating external data source %s failed", externalDataSource.Name), err)
+	}
+}
+
 func DropExternalDataSource(ctx context.Context, connection Connection, dataSourceId int64) {
 
 	externalDataSource := GetExternalDataSourceFromDataSourceId(ctx, connection, dataSourceId, false)
@@ -0,0 +1,22 @@
+package sql
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestBuildExternalDataSourceQuery(t *testing.T) {
+	externalDataSource := ExternalDataSource{
+		Location:     "myserver.database.windows.net",
+		Type:         "RDBMS",
+		DatabaseName: sql.NullString{String: "remote", Valid: true},
+		Pushdown:     sql.NullBool{Bool: false, Valid: true},
+	}
+
+	query := buildExternalDataSourceQuery("remote", "credential", externalDataSource)
+	expected := "create external data source [remote] with (location = 'myserver.database.windows.net', credential = [credential], type = RDBMS, database_name = 'remote', pushdown = off)"
+
+	if query != expected {
+		t.Errorf("Unexpected query %s", query)
+	}
+}
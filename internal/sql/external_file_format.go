@@ -0,0 +1,176 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+// External file format. The delimited text options are only used by DELIMITEDTEXT formats.
+type ExternalFileFormat struct {
+	Id              string
+	Connection      string
+	Name            string
+	FileFormatId    int64
+	FormatType      string
+	DataCompression sql.NullString
+	FieldTerminator sql.NullString
+	StringDelimiter sql.NullString
+	FirstRow        sql.NullInt64
+	UseTypeDefault  sql.NullBool
+	Encoding        sql.NullString
+}
+
+func externalFileFormatFormatId(connectionId string, fileFormatId int64) string {
+	return fmt.Sprintf("%s/externalfileformat/%d", connectionId, fileFormatId)
+}
+
+func isExternalFileFormatId(id string) bool {
+	return strings.Contains(id, "/externalfileformat/")
+}
+
+func ParseExternalFileFormatId(ctx context.Context, id string) (fileFormat ExternalFileFormat) {
+	s := strings.Split(id, "/externalfileformat/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /externalfileformat/ exactly once")
+		return
+	}
+
+	fileFormat.Connection = s[0]
+
+	fileFormatId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", fmt.Sprintf("Unable to parse external file format id %s", id))
+		return
+	}
+
+	fileFormat.FileFormatId = fileFormatId
+
+	return
+}
+
+func buildExternalFileFormatQuery(name string, fileFormat ExternalFileFormat) string {
+	options := []string{fmt.Sprintf("format_type = %s", fileFormat.FormatType)}
+
+	var formatOptions []string
+	if fileFormat.FieldTerminator.Valid {
+		formatOptions = append(formatOptions, fmt.Sprintf("field_terminator = %s", quoteString(fileFormat.FieldTerminator.String)))
+	}
+	if fileFormat.StringDelimiter.Valid {
+		formatOptions = append(formatOptions, fmt.Sprintf("string_delimiter = %s", quoteString(fileFormat.StringDelimiter.String)))
+	}
+	if fileFormat.FirstRow.Valid {
+		formatOptions = append(formatOptions, fmt.Sprintf("first_row = %d", fileFormat.FirstRow.Int64))
+	}
+	if fileFormat.UseTypeDefault.Valid {
+		formatOptions = append(formatOptions, fmt.Sprintf("use_type_default = %t", fileFormat.UseTypeDefault.Bool))
+	}
+	if fileFormat.Encoding.Valid {
+		formatOptions = append(formatOptions, fmt.Sprintf("encoding = %s", quoteString(fileFormat.Encoding.String)))
+	}
+	if len(formatOptions) > 0 {
+		options = append(options, fmt.Sprintf("format_options (%s)", strings.Join(formatOptions, ", ")))
+	}
+
+	if fileFormat.DataCompression.Valid {
+		options = append(options, fmt.Sprintf("data_compression = %s", quoteString(fileFormat.DataCompression.String)))
+	}
+
+	return fmt.Sprintf("create external file format %s with (%s)", quoteIdentifier(name), strings.Join(options, ", "))
+}
+
+func CreateExternalFileFormat(ctx context.Context, connection Connection, name string, fileFormat ExternalFileFormat) ExternalFileFormat {
+
+	_, err := connection.Connection.ExecContext(ctx, buildExternalFileFormatQuery(name, fileFormat))
+
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("External file format creation failed for %s", name), err)
+		return ExternalFileFormat{}
+	}
+
+	fileFormat = GetExternalFileFormatFromName(ctx, connection, name, false)
+	if !logging.HasError(ctx) && fileFormat.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created external file format", fmt.Sprintf("Unable to read external file format %s after creation.", name))
+	}
+
+	return fileFormat
+}
+
+func GetExternalFileFormatFromName(ctx context.Context, connection Connection, name string, requiresExist bool) (fileFormat ExternalFileFormat) {
+	var fileFormatId int64
+	query := "select file_format_id from sys.external_file_formats where name = @name"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name)).Scan(&fileFormatId)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "External file format not found", fmt.Sprintf("External file format with name %s doesn't exist", name))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading external file format %s failed", name), err)
+		return
+	}
+
+	return GetExternalFileFormatFromFileFormatId(ctx, connection, fileFormatId, requiresExist)
+}
+
+func GetExternalFileFormatFromFileFormatId(ctx context.Context, connection Connection, fileFormatId int64, requiresExist bool) (fileFormat ExternalFileFormat) {
+	query := `
+		select name, format_type, data_compression, field_terminator, string_delimiter, first_row, use_type_default, encoding
+		from sys.external_file_formats
+		where file_format_id = @id`
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("id", fileFormatId)).Scan(
+		&fileFormat.Name, &fileFormat.FormatType, &fileFormat.DataCompression,
+		&fileFormat.FieldTerminator, &fileFormat.StringDelimiter, &fileFormat.FirstRow,
+		&fileFormat.UseTypeDefault, &fileFormat.Encoding)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "External file format not found", fmt.Sprintf("External file format with id %d doesn't exist", fileFormatId))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading external file format %d failed", fileFormatId), err)
+		return
+	}
+
+	fileFormat.Id = externalFileFormatFormatId(connection.ConnectionId, fileFormatId)
+	fileFormat.Connection = connection.ConnectionId
+	fileFormat.FileFormatId = fileFormatId
+
+	return
+}
+
+func GetExternalFileFormatFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (fileFormat ExternalFileFormat) {
+	fileFormat = ParseExternalFileFormatId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if fileFormat.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	return GetExternalFileFormatFromFileFormatId(ctx, connection, fileFormat.FileFormatId, requiresExist)
+}
+
+func DropExternalFileFormat(ctx context.Context, connection Connection, id string) {
+
+	fileFormat := GetExternalFileFormatFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || fileFormat.Id == "" {
+		return
+	}
+
+	query := fmt.Sprintf("drop external file format %s", quoteIdentifier(fileFormat.Name))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping external file format %s failed", fileFormat.Name), err)
+	}
+}
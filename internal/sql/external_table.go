@@ -0,0 +1,219 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+type ExternalTable struct {
+	Id                string
+	Connection        string
+	Name              string
+	ObjectId          int64
+	Schema            string
+	Columns           []TypeColumn
+	Location          string
+	DataSource        string
+	FileFormat        string
+	RejectType        sql.NullString
+	RejectValue       sql.NullFloat64
+	RejectSampleValue sql.NullFloat64
+}
+
+func externalTableFormatId(connectionId string, objectId int64) string {
+	return fmt.Sprintf("%s/externaltable/%d", connectionId, objectId)
+}
+
+func isExternalTableId(id string) bool {
+	return strings.Contains(id, "/externaltable/")
+}
+
+func ParseExternalTableId(ctx context.Context, id string) (externalTable ExternalTable) {
+	s := strings.Split(id, "/externaltable/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /externaltable/ exactly once")
+		return
+	}
+
+	externalTable.Connection = s[0]
+
+	objectId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse external table id")
+		return
+	}
+
+	externalTable.ObjectId = objectId
+
+	return
+}
+
+func buildExternalTableQuery(schemaName string, name string, dataSourceName string, fileFormatName string, externalTable ExternalTable) string {
+	var columns []string
+	for _, column := range externalTable.Columns {
+		nullable := "not null"
+		if column.Nullable {
+			nullable = "null"
+		}
+		columns = append(columns, fmt.Sprintf("%s %s %s", quoteIdentifier(column.Name), column.Type, nullable))
+	}
+
+	options := []string{
+		fmt.Sprintf("location = %s", quoteString(externalTable.Location)),
+		fmt.Sprintf("data_source = %s", quoteIdentifier(dataSourceName)),
+	}
+	if fileFormatName != "" {
+		options = append(options, fmt.Sprintf("file_format = %s", quoteIdentifier(fileFormatName)))
+	}
+	if externalTable.RejectType.Valid {
+		options = append(options, fmt.Sprintf("reject_type = %s", externalTable.RejectType.String))
+	}
+	if externalTable.RejectValue.Valid {
+		options = append(options, fmt.Sprintf("reject_value = %s", strconv.FormatFloat(externalTable.RejectValue.Float64, 'f', -1, 64)))
+	}
+	if externalTable.RejectSampleValue.Valid {
+		options = append(options, fmt.Sprintf("reject_sample_value = %s", strconv.FormatFloat(externalTable.RejectSampleValue.Float64, 'f', -1, 64)))
+	}
+
+	return fmt.Sprintf("create external table %s.%s (\n\t%s\n)\nwith (%s)",
+		quoteIdentifier(schemaName), quoteIdentifier(name), strings.Join(columns, ",\n\t"), strings.Join(options, ", "))
+}
+
+func CreateExternalTable(ctx context.Context, connection Connection, name string, schemaResourceId string, externalTable ExternalTable) ExternalTable {
+
+	schema := GetSchemaFromId(ctx, connection, schemaResourceId, true)
+	if logging.HasError(ctx) {
+		return ExternalTable{}
+	}
+
+	dataSource := GetExternalDataSourceFromId(ctx, connection, externalTable.DataSource, true)
+	if logging.HasError(ctx) {
+		return ExternalTable{}
+	}
+
+	var fileFormatName string
+	if externalTable.FileFormat != "" {
+		fileFormat := GetExternalFileFormatFromId(ctx, connection, externalTable.FileFormat, true)
+		if logging.HasError(ctx) {
+			return ExternalTable{}
+		}
+		fileFormatName = fileFormat.Name
+	}
+
+	query := buildExternalTableQuery(schema.Name, name, dataSource.Name, fileFormatName, externalTable)
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, "External table creation failed", err)
+		return ExternalTable{}
+	}
+
+	externalTable = GetExternalTableFromNameAndSchema(ctx, connection, name, schemaResourceId, false)
+	if !logging.HasError(ctx) && externalTable.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created external table", fmt.Sprintf("Unable to read external table %s after creation.", name))
+	}
+
+	return externalTable
+}
+
+func GetExternalTableFromNameAndSchema(ctx context.Context, connection Connection, name string, schemaResourceId string, requiresExist bool) (externalTable ExternalTable) {
+	schema := ParseSchemaId(ctx, schemaResourceId)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	var objectId int64
+	query := "select object_id from sys.external_tables where name = @name and schema_id = @schema_id"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name), sql.Named("schema_id", schema.SchemaId)).Scan(&objectId)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "External table not found", fmt.Sprintf("External table with name %s doesn't exist", name))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading external table %s failed", name), err)
+		return
+	}
+
+	return GetExternalTableFromObjectId(ctx, connection, objectId, requiresExist)
+}
+
+func GetExternalTableFromObjectId(ctx context.Context, connection Connection, objectId int64, requiresExist bool) (externalTable ExternalTable) {
+	var schemaId, dataSourceId int64
+	var fileFormatId sql.NullInt64
+
+	query := `
+		select name, schema_id, location, data_source_id, file_format_id, reject_type, reject_value, reject_sample_value
+		from sys.external_tables
+		where object_id = @object_id`
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("object_id", objectId)).Scan(
+		&externalTable.Name, &schemaId, &externalTable.Location, &dataSourceId, &fileFormatId,
+		&externalTable.RejectType, &externalTable.RejectValue, &externalTable.RejectSampleValue)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "External table not found", fmt.Sprintf("External table with objectId %d doesn't exist", objectId))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading external table %d failed", objectId), err)
+		return
+	}
+
+	externalTable.Id = externalTableFormatId(connection.ConnectionId, objectId)
+	externalTable.Connection = connection.ConnectionId
+	externalTable.ObjectId = objectId
+	externalTable.Schema = schemaFormatId(connection.ConnectionId, schemaId)
+	externalTable.DataSource = externalDataSourceFormatId(connection.ConnectionId, dataSourceId)
+
+	if fileFormatId.Valid && fileFormatId.Int64 != 0 {
+		externalTable.FileFormat = externalFileFormatFormatId(connection.ConnectionId, fileFormatId.Int64)
+	}
+
+	if externalTable.RejectType.Valid {
+		externalTable.RejectType.String = strings.ToLower(externalTable.RejectType.String)
+	}
+
+	externalTable.Columns = getColumns(ctx, connection, objectId)
+
+	return
+}
+
+func GetExternalTableFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (externalTable ExternalTable) {
+	externalTable = ParseExternalTableId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if externalTable.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	return GetExternalTableFromObjectId(ctx, connection, externalTable.ObjectId, requiresExist)
+}
+
+func DropExternalTable(ctx context.Context, connection Connection, id string) {
+
+	externalTable := GetExternalTableFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || externalTable.Id == "" {
+		return
+	}
+	schema := GetSchemaFromId(ctx, connection, externalTable.Schema, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := fmt.Sprintf("drop external table %s.%s", quoteIdentifier(schema.Name), quoteIdentifier(externalTable.Name))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping external table %s.%s failed", schema.Name, externalTable.Name), err)
+	}
+}
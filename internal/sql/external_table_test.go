@@ -0,0 +1,78 @@
+package sql
+
+import (
+	"database/sql"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+	"testing"
+)
+
+func TestBuildExternalFileFormatQuery(t *testing.T) {
+	fileFormat := ExternalFileFormat{
+		FormatType:      "DELIMITEDTEXT",
+		FieldTerminator: sql.NullString{String: ",", Valid: true},
+		StringDelimiter: sql.NullString{String: "'", Valid: true},
+		FirstRow:        sql.NullInt64{Int64: 2, Valid: true},
+	}
+
+	query := buildExternalFileFormatQuery("csv", fileFormat)
+	expected := "create external file format [csv] with (format_type = DELIMITEDTEXT, format_options (field_terminator = ',', string_delimiter = '''', first_row = 2))"
+
+	if query != expected {
+		t.Errorf("Unexpected query %s", query)
+	}
+
+	query = buildExternalFileFormatQuery("parquet", ExternalFileFormat{FormatType: "PARQUET"})
+	if query != "create external file format [parquet] with (format_type = PARQUET)" {
+		t.Errorf("Unexpected query %s", query)
+	}
+}
+
+func TestBuildExternalTableQuery(t *testing.T) {
+	externalTable := ExternalTable{
+		Columns: []TypeColumn{
+			{Name: "id", Type: "int", Nullable: false},
+			{Name: "name", Type: "nvarchar(100)", Nullable: true},
+		},
+		Location:    "sales/**",
+		RejectType:  sql.NullString{String: "percentage", Valid: true},
+		RejectValue: sql.NullFloat64{Float64: 2.5, Valid: true},
+	}
+
+	query := buildExternalTableQuery("dbo", "sales", "lake", "parquet", externalTable)
+
+	for _, expected := range []string{
+		"create external table [dbo].[sales] (",
+		"[id] int not null",
+		"[name] nvarchar(100) null",
+		"location = 'sales/**'",
+		"data_source = [lake]",
+		"file_format = [parquet]",
+		"reject_type = percentage",
+		"reject_value = 2.5",
+	} {
+		if !strings.Contains(query, expected) {
+			t.Errorf("Query doesn't contain %s: %s", expected, query)
+		}
+	}
+
+	if strings.Contains(buildExternalTableQuery("dbo", "sales", "lake", "", externalTable), "file_format") {
+		t.Errorf("file_format should be omitted when no file format is specified")
+	}
+}
+
+func TestObjectFormatIdExternalTable(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	id := objectFormatId(ctx, "sqlserver::server:1433:db", 1234, "ET")
+	if logging.HasError(ctx) {
+		t.Fatalf("Unexpected error for object type ET")
+	}
+
+	if id != "sqlserver::server:1433:db/externaltable/1234" {
+		t.Errorf("Unexpected id %s", id)
+	}
+	if isTableId(id) {
+		t.Errorf("External table ids should not be recognised as table ids")
+	}
+}
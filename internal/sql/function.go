@@ -12,8 +12,10 @@ import (
 )
 
 type FunctionArgument struct {
-	Name string
-	Type string
+	Name     string
+	Type     string
+	Default  string
+	Readonly bool
 }
 
 type FunctionProps struct {
@@ -103,7 +105,8 @@ func buildFunctionQuery(name string, schemaName string, props FunctionProps) str
 		if index != 0 {
 			arguments += ", "
 		}
-		arguments += "@" + argument.Name + " " + argument.Type
+		// functions don't support output parameters
+		arguments += formatArgument(argument.Name, argument.Type, argument.Default, false, argument.Readonly)
 	}
 
 	var execute_as string
@@ -166,6 +169,25 @@ func CreateFunctionFromProperties(ctx context.Context, connection Connection, na
 	return CreateFunctionFromRaw(ctx, connection, name, schemaResourceId, query)
 }
 
+// Alters the function to the given properties, e.g. to update the definition in sys.sql_modules after a rename.
+func AlterFunctionFromProperties(ctx context.Context, connection Connection, id string, props FunctionProps) {
+	function := GetFunctionFromId(ctx, connection, id, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	schema := GetSchemaFromId(ctx, connection, function.Schema, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := strings.Replace(buildFunctionQuery(function.Name, schema.Name, props), "create function", "alter function", 1)
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Altering function %s.%s failed", schema.Name, function.Name), err)
+	}
+}
+
 func GetFunctionFromNameAndSchema(ctx context.Context, connection Connection, name string, schemaResourceId string, requiresExist bool) (function Function) {
 	schema := ParseSchemaId(ctx, schemaResourceId)
 
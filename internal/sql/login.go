@@ -9,11 +9,19 @@ import (
 )
 
 type Login struct {
-	Id         string
-	Connection string
-	Name       string
-	Password   string
-	Sid        string
+	Id                string
+	Connection        string
+	Name              string
+	Password          string
+	Sid               string
+	Authentication    string
+	EntraIDIdentifier string
+	Enabled           bool
+	DefaultDatabase   string
+	DefaultLanguage   string
+	CheckPolicy       sql.NullBool
+	CheckExpiration   sql.NullBool
+	MustChange        bool
 }
 
 func loginFormatId(connectionId string, name string, sid string) string {
@@ -48,74 +56,195 @@ func ParseLoginId(ctx context.Context, id string) (login Login) {
 	return
 }
 
-func CreateLogin(ctx context.Context, connection Connection, name string, password string) (login Login) {
-	query := fmt.Sprintf("create login %s with password = '%s'", name, password)
+// Options which are not specified (empty or invalid) are left to the server defaults.
+// check_expiration can only be enabled together with check_policy, so check_policy is
+// specified first when it is enabled and last when it is disabled.
+func buildLoginOptions(login Login) (options []string) {
+	if login.DefaultDatabase != "" {
+		options = append(options, "default_database = "+quoteIdentifier(login.DefaultDatabase))
+	}
+
+	if login.DefaultLanguage != "" {
+		options = append(options, "default_language = "+quoteIdentifier(login.DefaultLanguage))
+	}
+
+	var checks []string
+	if login.CheckExpiration.Valid {
+		checks = append(checks, "check_expiration = "+onOff(login.CheckExpiration.Bool))
+	}
+	if login.CheckPolicy.Valid {
+		checkPolicy := "check_policy = " + onOff(login.CheckPolicy.Bool)
+		if login.CheckPolicy.Bool {
+			checks = append([]string{checkPolicy}, checks...)
+		} else {
+			checks = append(checks, checkPolicy)
+		}
+	}
+
+	return append(options, checks...)
+}
+
+// Authentication is either SQL or AzureAD.
+// An AzureAD login is resolved by name, unless the object id or application (client) id is given as EntraIDIdentifier.
+// Sid is a binary constant (e.g. 0x01AB), which is validated by the resource schema.
+func buildCreateLoginQuery(login Login) string {
+	var options []string
+
+	if login.Authentication != "AzureAD" {
+		password := "password = " + quoteString(login.Password)
+		if login.MustChange {
+			password += " must_change"
+		}
+		options = append(options, password)
+		if login.Sid != "" {
+			options = append(options, "sid = "+login.Sid)
+		}
+		options = append(options, buildLoginOptions(login)...)
+
+		return fmt.Sprintf("create login %s with %s", quoteIdentifier(login.Name), strings.Join(options, ", "))
+	}
+
+	query := fmt.Sprintf("create login %s from external provider", quoteIdentifier(login.Name))
+	if login.EntraIDIdentifier != "" {
+		options = append(options, "object_id = "+quoteString(login.EntraIDIdentifier))
+	}
+	options = append(options, buildLoginOptions(login)...)
+
+	if len(options) > 0 {
+		query += " with " + strings.Join(options, ", ")
+	}
+	return query
+}
+
+func CreateLogin(ctx context.Context, connection Connection, properties Login) (login Login) {
+	query := buildCreateLoginQuery(properties)
 
 	_, err := connection.Connection.ExecContext(ctx, query)
-	logging.AddError(ctx, fmt.Sprintf("Login creation failed for login %s", name), err)
+	logging.AddError(ctx, fmt.Sprintf("Login creation failed for login %s", properties.Name), err)
+
+	if !logging.HasError(ctx) && !properties.Enabled {
+		UpdateLoginEnabled(ctx, connection, properties.Name, false)
+	}
 
-	login = GetLoginFromName(ctx, connection, name)
+	login = GetLoginFromName(ctx, connection, properties.Name)
 	if logging.HasError(ctx) || login.Id == "" {
-		logging.AddError(ctx, "Resource creation failed", fmt.Sprintf("Unable to read login %s after creation.", name))
+		logging.AddError(ctx, "Resource creation failed", fmt.Sprintf("Unable to read login %s after creation.", properties.Name))
 	}
 
-	login.Password = password
+	if properties.Authentication != "AzureAD" {
+		login.Password = properties.Password
+	}
 
 	return login
 }
 
-func GetLoginFromName(ctx context.Context, connection Connection, name string) (login Login) {
+// S are SQL logins, E and X are Entra ID logins of users/applications and groups
+func describeLoginAuthentication(loginType string) string {
+	if loginType == "E" || loginType == "X" {
+		return "AzureAD"
+	}
+	return "SQL"
+}
+
+// sid is stored as varbinary, convert returns the hexadecimal representation as a string
+// this is the most usefull go representation for performing future queries
+// the sid of Entra ID logins is the object id or application id
+// password policy settings are only available for SQL logins in sys.sql_logins
+const loginQuery = `
+	select p.name, convert(varchar(max), p.sid, 1) as sid, p.type,
+		case when p.type in ('E', 'X') then lower(convert(uniqueidentifier, p.sid)) end as entraid_identifier,
+		p.is_disabled, p.default_database_name, p.default_language_name,
+		l.is_policy_checked, l.is_expiration_checked
+	from sys.server_principals p
+	left join sys.sql_logins l on l.principal_id = p.principal_id
+	where p.type in ('S', 'E', 'X')`
 
-	var sid string
-	// sid is stored as varbinary, convert returns the hexadecimal representation as a string
-	// this is the most usefull go representation for performing future queries
-	query := fmt.Sprintf("select convert(varchar(max), sid, 1) as sid from sys.sql_logins where name = '%s'", name)
+func scanLogin(ctx context.Context, connection Connection, row *sql.Row, description string) (login Login) {
+	var loginType string
+	var entraidIdentifier, defaultDatabase, defaultLanguage sql.NullString
+	var disabled bool
 
-	err := (connection.
-		Connection.
-		QueryRowContext(ctx, query).
-		Scan(&sid))
+	err := row.Scan(&login.Name, &login.Sid, &loginType, &entraidIdentifier,
+		&disabled, &defaultDatabase, &defaultLanguage, &login.CheckPolicy, &login.CheckExpiration)
 
 	switch {
 	case err == sql.ErrNoRows:
 		// login doesn't exist
-		return
+		return Login{}
 	case err != nil:
-		logging.AddError(ctx, fmt.Sprintf("Reading login %s failed", name), err)
-		return
+		logging.AddError(ctx, fmt.Sprintf("Reading login %s failed", description), err)
+		return Login{}
 	}
 
-	return Login{
-		Id:         loginFormatId(connection.ConnectionId, name, sid),
-		Connection: connection.ConnectionId,
-		Name:       name,
-		Sid:        sid,
-	}
+	login.Id = loginFormatId(connection.ConnectionId, login.Name, login.Sid)
+	login.Connection = connection.ConnectionId
+	login.Authentication = describeLoginAuthentication(loginType)
+	login.EntraIDIdentifier = entraidIdentifier.String
+	login.Enabled = !disabled
+	login.DefaultDatabase = defaultDatabase.String
+	login.DefaultLanguage = defaultLanguage.String
+
+	return
+}
+
+func GetLoginFromName(ctx context.Context, connection Connection, name string) (login Login) {
+	row := connection.Connection.QueryRowContext(ctx, loginQuery+" and p.name = @name", sql.Named("name", name))
+	return scanLogin(ctx, connection, row, name)
 }
 
 func GetLoginFromSid(ctx context.Context, connection Connection, sid string) (login Login) {
-	var name string
-	query := fmt.Sprintf("select name from sys.sql_logins where sid = %s", sid)
+	row := connection.Connection.QueryRowContext(ctx, loginQuery+" and p.sid = convert(varbinary(85), @sid, 1)", sql.Named("sid", sid))
+	return scanLogin(ctx, connection, row, sid)
+}
 
-	err := (connection.
-		Connection.
-		QueryRowContext(ctx, query).
-		Scan(&name))
+// Renaming keeps the sid, so users created from the login keep working.
+func UpdateLoginName(ctx context.Context, connection Connection, name string, newName string) {
+	query := fmt.Sprintf("alter login %s with name = %s", quoteIdentifier(name), quoteIdentifier(newName))
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Renaming login %s to %s failed", name, newName), err)
+	}
+}
 
-	switch {
-	case err == sql.ErrNoRows:
-		// login doesn't exist
-		return
-	case err != nil:
-		logging.AddError(ctx, fmt.Sprintf("Reading login %s failed", sid), err)
+// The old password is only required when the connection lacks the alter any login permission.
+// must_change requires check_policy and check_expiration and can't be combined with an old password.
+func buildUpdateLoginPasswordQuery(name string, password string, oldPassword string, mustChange bool) string {
+	query := fmt.Sprintf("alter login %s with password = %s", quoteIdentifier(name), quoteString(password))
+	if oldPassword != "" {
+		query += " old_password = " + quoteString(oldPassword)
+	} else if mustChange {
+		query += " must_change"
+	}
+	return query
+}
+
+func UpdateLoginPassword(ctx context.Context, connection Connection, name string, password string, oldPassword string, mustChange bool) {
+	query := buildUpdateLoginPasswordQuery(name, password, oldPassword, mustChange)
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Changing password of login %s failed", name), err)
+	}
+}
+
+// Disabling a login denies new connections, without dropping the login or the users created from it.
+func UpdateLoginEnabled(ctx context.Context, connection Connection, name string, enabled bool) {
+	query := fmt.Sprintf("alter login %s disable", quoteIdentifier(name))
+	if enabled {
+		query = fmt.Sprintf("alter login %s enable", quoteIdentifier(name))
+	}
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Changing status of login %s failed", name), err)
+	}
+}
+
+// Only the options specified in properties are changed.
+func UpdateLoginOptions(ctx context.Context, connection Connection, name string, properties Login) {
+	options := buildLoginOptions(properties)
+	if len(options) == 0 {
 		return
 	}
 
-	return Login{
-		Id:         loginFormatId(connection.ConnectionId, name, sid),
-		Connection: connection.ConnectionId,
-		Name:       name,
-		Sid:        sid,
+	query := fmt.Sprintf("alter login %s with %s", quoteIdentifier(name), strings.Join(options, ", "))
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Changing options of login %s failed", name), err)
 	}
 }
 
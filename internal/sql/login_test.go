@@ -0,0 +1,92 @@
+package sql
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestBuildCreateLoginQuery(t *testing.T) {
+	tests := []struct {
+		login    Login
+		expected string
+	}{
+		{
+			Login{Name: "my]login", Password: "pa'ss", Authentication: "SQL"},
+			"create login [my]]login] with password = 'pa''ss'",
+		},
+		{
+			Login{Name: "my]login", Password: "pa'ss", Sid: "0x0106000000000064000000000000000028B1F8C5B1B0A74E", Authentication: "SQL"},
+			"create login [my]]login] with password = 'pa''ss', sid = 0x0106000000000064000000000000000028B1F8C5B1B0A74E",
+		},
+		{
+			Login{
+				Name:            "mylogin",
+				Password:        "pass",
+				Authentication:  "SQL",
+				MustChange:      true,
+				DefaultDatabase: "mydb",
+				DefaultLanguage: "us_english",
+				CheckPolicy:     sql.NullBool{Bool: true, Valid: true},
+				CheckExpiration: sql.NullBool{Bool: true, Valid: true},
+			},
+			"create login [mylogin] with password = 'pass' must_change, default_database = [mydb], default_language = [us_english], check_policy = on, check_expiration = on",
+		},
+		{
+			Login{Name: "my]login", Authentication: "AzureAD"},
+			"create login [my]]login] from external provider",
+		},
+		{
+			Login{Name: "my]login", Authentication: "AzureAD", EntraIDIdentifier: "5a0b8a6c-2f9a-4a8e-9b1c-6f1d5e3c7b2a", DefaultDatabase: "mydb"},
+			"create login [my]]login] from external provider with object_id = '5a0b8a6c-2f9a-4a8e-9b1c-6f1d5e3c7b2a', default_database = [mydb]",
+		},
+	}
+
+	for _, test := range tests {
+		query := buildCreateLoginQuery(test.login)
+		if query != test.expected {
+			t.Errorf("Unexpected query %s", query)
+		}
+	}
+}
+
+func TestBuildLoginOptions(t *testing.T) {
+	options := buildLoginOptions(Login{
+		CheckPolicy:     sql.NullBool{Bool: false, Valid: true},
+		CheckExpiration: sql.NullBool{Bool: false, Valid: true},
+	})
+
+	if len(options) != 2 || options[0] != "check_expiration = off" || options[1] != "check_policy = off" {
+		t.Errorf("Unexpected options %v", options)
+	}
+
+	if options := buildLoginOptions(Login{}); len(options) != 0 {
+		t.Errorf("Unexpected options %v", options)
+	}
+}
+
+func TestBuildUpdateLoginPasswordQuery(t *testing.T) {
+	tests := []struct {
+		oldPassword string
+		mustChange  bool
+		expected    string
+	}{
+		{"", false, "alter login [mylogin] with password = 'new'"},
+		{"o'ld", false, "alter login [mylogin] with password = 'new' old_password = 'o''ld'"},
+		{"", true, "alter login [mylogin] with password = 'new' must_change"},
+	}
+
+	for _, test := range tests {
+		query := buildUpdateLoginPasswordQuery("mylogin", "new", test.oldPassword, test.mustChange)
+		if query != test.expected {
+			t.Errorf("Unexpected query %s", query)
+		}
+	}
+}
+
+func TestDescribeLoginAuthentication(t *testing.T) {
+	for loginType, expected := range map[string]string{"S": "SQL", "E": "AzureAD", "X": "AzureAD"} {
+		if actual := describeLoginAuthentication(loginType); actual != expected {
+			t.Errorf("Unexpected authentication %s for type %s", actual, loginType)
+		}
+	}
+}
@@ -31,10 +31,16 @@ func ParseMasterKeyId(ctx context.Context, id string) (masterKey MasterKey) {
 	return
 }
 
-func CreateMasterKey(ctx context.Context, connection Connection) (masterKey MasterKey) {
-	password := GeneratePassword(20, 3, 4, 5)
+// Create the master key, encrypted by the given password. A password is generated using the policy when left empty.
+func CreateMasterKey(ctx context.Context, connection Connection, password string, policy PasswordPolicy) (masterKey MasterKey) {
+	if password == "" {
+		password = GeneratePassword(ctx, policy)
+		if logging.HasError(ctx) {
+			return
+		}
+	}
 
-	query := fmt.Sprintf("create master key encryption by password = '%s'", password)
+	query := fmt.Sprintf("create master key encryption by password = %s", quoteString(password))
 
 	_, err := connection.Connection.ExecContext(ctx, query)
 	logging.AddError(ctx, "Creation of master key failed", err)
@@ -46,6 +52,30 @@ func CreateMasterKey(ctx context.Context, connection Connection) (masterKey Mast
 	}
 }
 
+// Replace the password encrypting the master key. The new password is added before the old one
+// is dropped, as the master key should always be encrypted by at least one password.
+func ChangeMasterKeyPassword(ctx context.Context, connection Connection, oldPassword string, newPassword string) {
+	query := fmt.Sprintf("alter master key add encryption by password = %s", quoteString(newPassword))
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, "Adding password to master key failed", err)
+		return
+	}
+
+	query = fmt.Sprintf("alter master key drop encryption by password = %s", quoteString(oldPassword))
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, "Dropping old password from master key failed", err)
+	}
+}
+
+// Regenerate the master key and all keys it protects, encrypting it by the given password.
+// The regenerated key is no longer encrypted by any previous password.
+func RegenerateMasterKey(ctx context.Context, connection Connection, password string) {
+	query := fmt.Sprintf("alter master key regenerate with encryption by password = %s", quoteString(password))
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Regenerating master key failed for database %s", connection.ConnectionId), err)
+	}
+}
+
 func MasterKeyExists(ctx context.Context, connection Connection) bool {
 
 	query := fmt.Sprintf("select 1 from sys.symmetric_keys where name = '##MS_DatabaseMasterKey##'")
@@ -0,0 +1,301 @@
+package sql
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type Migration struct {
+	Id       string
+	Script   string
+	Checksum string
+}
+
+// Table in which the applied migrations are recorded.
+// Applied maps the id of every applied migration to the checksum of its script.
+type MigrationHistory struct {
+	Id         string
+	Connection string
+	Name       string
+	Schema     string
+	ObjectId   int64
+	Applied    map[string]string
+}
+
+func migrationHistoryFormatId(connectionId string, objectId int64) string {
+	return fmt.Sprintf("%s/migrations/%d", connectionId, objectId)
+}
+
+func ParseMigrationHistoryId(ctx context.Context, id string) (history MigrationHistory) {
+	s := strings.Split(id, "/migrations/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /migrations/ exactly once")
+		return
+	}
+
+	history.Connection = s[0]
+
+	objectId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse migrations id")
+		return
+	}
+
+	history.ObjectId = objectId
+
+	return
+}
+
+// Checksum of a migration script. Used when no explicit checksum is configured.
+func MigrationChecksum(script string) string {
+	hash := sha256.Sum256([]byte(script))
+	return hex.EncodeToString(hash[:])
+}
+
+func (migration Migration) ExpectedChecksum() string {
+	if migration.Checksum != "" {
+		return migration.Checksum
+	}
+	return MigrationChecksum(migration.Script)
+}
+
+func CreateMigrationHistory(ctx context.Context, connection Connection, name string, schemaResourceId string) (history MigrationHistory) {
+
+	schema := GetSchemaFromId(ctx, connection, schemaResourceId, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := fmt.Sprintf(`
+		create table %s.%s (
+			id nvarchar(200) not null primary key,
+			checksum varchar(128) not null,
+			applied_on datetime2 not null default sysutcdatetime(),
+			applied_by nvarchar(128) not null default suser_sname()
+		)`, quoteIdentifier(schema.Name), quoteIdentifier(name))
+
+	_, err := connection.Connection.ExecContext(ctx, query)
+	logging.AddError(ctx, fmt.Sprintf("Creating migration history table %s.%s failed", schema.Name, name), err)
+
+	// set requiresExist to false in order to specify a custom error message
+	history = GetMigrationHistoryFromNameAndSchema(ctx, connection, name, schemaResourceId, false)
+	if !logging.HasError(ctx) && history.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created migration history", fmt.Sprintf("Unable to read migration history table %s after creation.", name))
+	}
+
+	return history
+}
+
+func GetMigrationHistoryFromNameAndSchema(ctx context.Context, connection Connection, name string, schemaResourceId string, requiresExist bool) (history MigrationHistory) {
+	schema := ParseSchemaId(ctx, schemaResourceId)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	var objectId int64
+	query := "select object_id from sys.tables where name = @name and schema_id = @schema_id"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name), sql.Named("schema_id", schema.SchemaId)).Scan(&objectId)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Migration history not found", fmt.Sprintf("Migration history table %s doesn't exist", name))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading migration history table %s failed", name), err)
+		return
+	}
+
+	return GetMigrationHistoryFromObjectId(ctx, connection, objectId, requiresExist)
+}
+
+func GetMigrationHistoryFromObjectId(ctx context.Context, connection Connection, objectId int64, requiresExist bool) (history MigrationHistory) {
+	var schemaId int64
+	var name, schemaName string
+
+	query := "select name, schema_id, schema_name(schema_id) from sys.tables where object_id = @object_id"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("object_id", objectId)).Scan(&name, &schemaId, &schemaName)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Migration history not found", fmt.Sprintf("Migration history table with object id %d doesn't exist", objectId))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading migration history table %d failed", objectId), err)
+		return
+	}
+
+	history = MigrationHistory{
+		Id:         migrationHistoryFormatId(connection.ConnectionId, objectId),
+		Connection: connection.ConnectionId,
+		Name:       name,
+		Schema:     schemaFormatId(connection.ConnectionId, schemaId),
+		ObjectId:   objectId,
+		Applied:    map[string]string{},
+	}
+
+	query = fmt.Sprintf("select id, checksum from %s.%s", quoteIdentifier(schemaName), quoteIdentifier(name))
+
+	rows, err := connection.Connection.QueryContext(ctx, query)
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading applied migrations from %s.%s failed", schemaName, name), err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, checksum string
+		if err := rows.Scan(&id, &checksum); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Reading applied migrations from %s.%s failed", schemaName, name), err)
+			return
+		}
+		history.Applied[id] = checksum
+	}
+
+	return history
+}
+
+func GetMigrationHistoryFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (history MigrationHistory) {
+	history = ParseMigrationHistoryId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if history.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	return GetMigrationHistoryFromObjectId(ctx, connection, history.ObjectId, requiresExist)
+}
+
+// Return the migrations which still have to be applied, in order.
+// Raises an error when an applied migration no longer matches its checksum
+// or when a pending migration is ordered before an applied one.
+func PendingMigrations(ctx context.Context, history MigrationHistory, migrations []Migration) (pending []Migration) {
+	seen := map[string]bool{}
+	lastApplied := -1
+
+	for index, migration := range migrations {
+		if seen[migration.Id] {
+			logging.AddError(ctx, "Duplicate migration", fmt.Sprintf("Migration %s is defined more than once", migration.Id))
+			return nil
+		}
+		seen[migration.Id] = true
+
+		checksum, applied := history.Applied[migration.Id]
+		if !applied {
+			continue
+		}
+
+		if checksum != migration.ExpectedChecksum() {
+			logging.AddError(ctx, "Checksum mismatch",
+				fmt.Sprintf("Migration %s was applied with checksum %s, but the configured migration has checksum %s. "+
+					"Applied migrations cannot be changed, add a new migration instead.", migration.Id, checksum, migration.ExpectedChecksum()))
+			return nil
+		}
+		lastApplied = index
+	}
+
+	for index, migration := range migrations {
+		if _, applied := history.Applied[migration.Id]; applied {
+			continue
+		}
+		if index < lastApplied {
+			logging.AddError(ctx, "Migration out of order",
+				fmt.Sprintf("Migration %s is pending, but is ordered before migration %s which is already applied.", migration.Id, migrations[lastApplied].Id))
+			return nil
+		}
+		pending = append(pending, migration)
+	}
+
+	return pending
+}
+
+// Apply all pending migrations. Every migration runs in its own transaction
+// together with the insert in the history table.
+func ApplyMigrations(ctx context.Context, connection Connection, id string, migrations []Migration) (history MigrationHistory) {
+	history = GetMigrationHistoryFromId(ctx, connection, id, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	pending := PendingMigrations(ctx, history, migrations)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	schema := GetSchemaFromId(ctx, connection, history.Schema, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	insert := fmt.Sprintf("insert into %s.%s (id, checksum) values (@id, @checksum)", quoteIdentifier(schema.Name), quoteIdentifier(history.Name))
+
+	for _, migration := range pending {
+		tflog.Info(ctx, fmt.Sprintf("Applying migration %s", migration.Id))
+
+		tx, err := connection.Connection.BeginTx(ctx, nil)
+		if err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Starting transaction for migration %s failed", migration.Id), err)
+			return
+		}
+
+		for _, batch := range SplitBatches(migration.Script) {
+			if _, err = tx.ExecContext(ctx, batch); err != nil {
+				break
+			}
+		}
+
+		if err == nil {
+			_, err = tx.ExecContext(ctx, insert, sql.Named("id", migration.Id), sql.Named("checksum", migration.ExpectedChecksum()))
+		}
+
+		if err != nil {
+			tx.Rollback()
+			logging.AddError(ctx, fmt.Sprintf("Applying migration %s failed", migration.Id), err)
+			return
+		}
+
+		if err = tx.Commit(); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Committing migration %s failed", migration.Id), err)
+			return
+		}
+
+		history.Applied[migration.Id] = migration.ExpectedChecksum()
+	}
+
+	return history
+}
+
+func DropMigrationHistory(ctx context.Context, connection Connection, id string) {
+
+	history := GetMigrationHistoryFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || history.Id == "" {
+		return
+	}
+
+	schema := GetSchemaFromId(ctx, connection, history.Schema, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := fmt.Sprintf("drop table %s.%s", quoteIdentifier(schema.Name), quoteIdentifier(history.Name))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping migration history table %s.%s failed", schema.Name, history.Name), err)
+	}
+}
@@ -0,0 +1,57 @@
+package sql
+
+import (
+	"terraform-provider-azuresql/internal/logging"
+	"testing"
+)
+
+func TestPendingMigrations(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	migrations := []Migration{
+		{Id: "0001", Script: "select 1"},
+		{Id: "0002", Script: "select 2"},
+		{Id: "0003", Script: "select 3", Checksum: "custom"},
+	}
+	history := MigrationHistory{Applied: map[string]string{"0001": MigrationChecksum("select 1")}}
+
+	pending := PendingMigrations(ctx, history, migrations)
+	if logging.HasError(ctx) {
+		t.Fatalf("Unexpected error")
+	}
+
+	if len(pending) != 2 || pending[0].Id != "0002" || pending[1].Id != "0003" {
+		t.Errorf("Expected pending migrations 0002 and 0003, got %v", pending)
+	}
+
+	if pending[1].ExpectedChecksum() != "custom" {
+		t.Errorf("Configured checksum should be used, got %s", pending[1].ExpectedChecksum())
+	}
+}
+
+func TestPendingMigrationsChecksumMismatch(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	migrations := []Migration{{Id: "0001", Script: "select 10"}}
+	history := MigrationHistory{Applied: map[string]string{"0001": MigrationChecksum("select 1")}}
+
+	PendingMigrations(ctx, history, migrations)
+	if !logging.HasError(ctx) {
+		t.Errorf("Changed migration should raise an error")
+	}
+}
+
+func TestPendingMigrationsOutOfOrder(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	migrations := []Migration{
+		{Id: "0001", Script: "select 1"},
+		{Id: "0002", Script: "select 2"},
+	}
+	history := MigrationHistory{Applied: map[string]string{"0002": MigrationChecksum("select 2")}}
+
+	PendingMigrations(ctx, history, migrations)
+	if !logging.HasError(ctx) {
+		t.Errorf("Pending migration before an applied migration should raise an error")
+	}
+}
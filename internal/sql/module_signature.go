@@ -0,0 +1,199 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+// Signature of a procedure or function by a certificate or asymmetric key.
+// Module is the id of the signed procedure or function, Key the id of the certificate or asymmetric key.
+type ModuleSignature struct {
+	Id         string
+	Connection string
+	ObjectId   int64
+	KeyType    string
+	KeyId      int64
+	Module     string
+	Key        string
+}
+
+func moduleSignatureFormatId(connectionId string, objectId int64, keyType string, keyId int64) string {
+	return fmt.Sprintf("%s/modulesignature/%d/%s/%d", connectionId, objectId, keyType, keyId)
+}
+
+func ParseModuleSignatureId(ctx context.Context, id string) (signature ModuleSignature) {
+	s := strings.Split(id, "/modulesignature/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /modulesignature/ exactly once")
+		return
+	}
+
+	signature.Connection = s[0]
+
+	s = strings.Split(s[1], "/")
+	if len(s) != 3 || (s[1] != "certificate" && s[1] != "asymmetrickey") {
+		logging.AddError(ctx, "Invalid id", "Unable to parse module signature id")
+		return
+	}
+
+	objectId, err := strconv.ParseInt(s[0], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse module signature id")
+		return
+	}
+
+	keyId, err := strconv.ParseInt(s[2], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse module signature id")
+		return
+	}
+
+	signature.ObjectId = objectId
+	signature.KeyType = s[1]
+	signature.KeyId = keyId
+	return
+}
+
+func parseModuleObjectId(ctx context.Context, moduleResourceId string) int64 {
+	switch {
+	case isProcedureId(moduleResourceId):
+		return ParseProcedureId(ctx, moduleResourceId).ObjectId
+	case isFunctionId(moduleResourceId):
+		return ParseFunctionId(ctx, moduleResourceId).ObjectId
+	default:
+		logging.AddError(ctx, "Invalid module", fmt.Sprintf("%s is not the id of a procedure or function", moduleResourceId))
+		return 0
+	}
+}
+
+// Quoted name of the signed module and the BY clause of the signature.
+func moduleSignatureTarget(ctx context.Context, connection Connection, signature ModuleSignature) (module string, by string) {
+	var schemaName, name, objectType string
+
+	query := "select schema_name(schema_id), name, type from sys.objects where object_id = @object_id"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("object_id", signature.ObjectId)).Scan(&schemaName, &name, &objectType)
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading module %d failed", signature.ObjectId), err)
+		return
+	}
+
+	if signature.KeyType == "certificate" {
+		certificate := GetCertificateFromCertificateId(ctx, connection, signature.KeyId, true)
+		by = "certificate " + quoteIdentifier(certificate.Name)
+	} else {
+		asymmetricKey := GetAsymmetricKeyFromAsymmetricKeyId(ctx, connection, signature.KeyId, true)
+		by = "asymmetric key " + quoteIdentifier(asymmetricKey.Name)
+	}
+
+	return fmt.Sprintf("%s.%s", quoteIdentifier(schemaName), quoteIdentifier(name)), by
+}
+
+// Sign a procedure or function with a certificate or asymmetric key. The private key
+// of the key should be encrypted by the database master key.
+func CreateModuleSignature(ctx context.Context, connection Connection, moduleResourceId string, keyResourceId string) (signature ModuleSignature) {
+	signature.ObjectId = parseModuleObjectId(ctx, moduleResourceId)
+
+	if isCertificateId(keyResourceId) {
+		signature.KeyType = "certificate"
+		signature.KeyId = ParseCertificateId(ctx, keyResourceId).CertificateId
+	} else if isAsymmetricKeyId(keyResourceId) {
+		signature.KeyType = "asymmetrickey"
+		signature.KeyId = ParseAsymmetricKeyId(ctx, keyResourceId).AsymmetricKeyId
+	} else {
+		logging.AddError(ctx, "Invalid key", fmt.Sprintf("%s is not the id of a certificate or asymmetric key", keyResourceId))
+	}
+
+	if logging.HasError(ctx) {
+		return ModuleSignature{}
+	}
+
+	module, by := moduleSignatureTarget(ctx, connection, signature)
+	if logging.HasError(ctx) {
+		return ModuleSignature{}
+	}
+
+	if _, err := connection.Connection.ExecContext(ctx, fmt.Sprintf("add signature to %s by %s", module, by)); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Signing %s failed", module), err)
+		return ModuleSignature{}
+	}
+
+	id := moduleSignatureFormatId(connection.ConnectionId, signature.ObjectId, signature.KeyType, signature.KeyId)
+	signature = GetModuleSignatureFromId(ctx, connection, id, false)
+	if !logging.HasError(ctx) && signature.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created module signature", fmt.Sprintf("Unable to read signature of %s after creation.", module))
+	}
+
+	return signature
+}
+
+// Get a module signature from its id. Altering a module drops its signatures,
+// in which case an empty signature is returned.
+func GetModuleSignatureFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (signature ModuleSignature) {
+	signature = ParseModuleSignatureId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if signature.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	keys := "sys.certificates where certificate_id = @key_id"
+	if signature.KeyType == "asymmetrickey" {
+		keys = "sys.asymmetric_keys where asymmetric_key_id = @key_id"
+	}
+
+	var objectType string
+	query := fmt.Sprintf(`
+		select obj.type
+		from sys.crypt_properties cp
+		inner join sys.objects obj on obj.object_id = cp.major_id
+		where cp.class = 1 and cp.major_id = @object_id
+		and cp.thumbprint = (select thumbprint from %s)`, keys)
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("object_id", signature.ObjectId), sql.Named("key_id", signature.KeyId)).Scan(&objectType)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Module signature not found", fmt.Sprintf("Module signature with id %s doesn't exist", id))
+		}
+		return ModuleSignature{}
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading module signature %s failed", id), err)
+		return ModuleSignature{}
+	}
+
+	signature.Id = id
+	signature.Module = objectFormatId(ctx, connection.ConnectionId, signature.ObjectId, objectType)
+	if signature.KeyType == "certificate" {
+		signature.Key = certificateFormatId(connection.ConnectionId, signature.KeyId)
+	} else {
+		signature.Key = asymmetricKeyFormatId(connection.ConnectionId, signature.KeyId)
+	}
+
+	return
+}
+
+func DropModuleSignature(ctx context.Context, connection Connection, id string) {
+
+	signature := GetModuleSignatureFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || signature.Id == "" {
+		return
+	}
+
+	module, by := moduleSignatureTarget(ctx, connection, signature)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if _, err := connection.Connection.ExecContext(ctx, fmt.Sprintf("drop signature from %s by %s", module, by)); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping signature of %s failed", module), err)
+	}
+}
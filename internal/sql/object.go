@@ -0,0 +1,35 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+// Renames a schema scoped object with sp_rename, which keeps the object id.
+// The definition of views, functions and procedures in sys.sql_modules still contains the old name
+// until the module is altered.
+func RenameObject(ctx context.Context, connection Connection, objectId int64, name string) {
+	var schemaName, objectName string
+
+	query := "select schema_name(schema_id), name from sys.objects where object_id = @object_id"
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("object_id", objectId)).Scan(&schemaName, &objectName)
+	switch {
+	case err == sql.ErrNoRows:
+		logging.AddError(ctx, "Object not found", fmt.Sprintf("Object with id %d doesn't exist", objectId))
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading object %d failed", objectId), err)
+		return
+	}
+
+	query = "exec sp_rename @objname = @objname, @newname = @newname, @objtype = 'OBJECT'"
+	_, err = connection.Connection.ExecContext(ctx, query,
+		sql.Named("objname", quoteIdentifier(schemaName)+"."+quoteIdentifier(objectName)),
+		sql.Named("newname", name))
+
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Renaming %s.%s to %s failed", schemaName, objectName, name), err)
+	}
+}
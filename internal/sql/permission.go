@@ -13,6 +13,7 @@ type Scope struct {
 	ResourceType string
 	Name         string
 	Id           int64
+	MinorId      int64
 }
 
 type Permission struct {
@@ -20,6 +21,7 @@ type Permission struct {
 	Connection  string
 	Scope       string
 	ScopeId     int64
+	MinorId     int64
 	Principal   string
 	PrincipalId int64
 	Permission  string
@@ -27,7 +29,11 @@ type Permission struct {
 	Action      string
 }
 
-func permissionFormatId(connectionId string, principalId int64, permission string, permissionType string, targetId int64) string {
+func permissionFormatId(connectionId string, principalId int64, permission string, permissionType string, targetId int64, minorId int64) string {
+	// column permissions are identified by the object id and the column id
+	if permissionType == "column" {
+		return fmt.Sprintf("%s/permission/%d/%s/%s/%d/%d", connectionId, principalId, permission, permissionType, targetId, minorId)
+	}
 	return fmt.Sprintf("%s/permission/%d/%s/%s/%d", connectionId, principalId, permission, permissionType, targetId)
 }
 
@@ -42,12 +48,12 @@ func ParsePermissionId(ctx context.Context, id string) (permission Permission) {
 	permission.Connection = s[0]
 
 	s = strings.Split(s[1], "/")
-	if len(s) != 4 {
+	if len(s) != 4 && !(len(s) == 5 && s[2] == "column") {
 		logging.AddError(ctx, "Invalid id", "Unable to parse permission id")
 		return
 	}
 
-	var principal_id, scope_id int64
+	var principal_id, scope_id, minor_id int64
 	var err error
 
 	principal_id, err = strconv.ParseInt(s[0], 10, 64)
@@ -62,7 +68,16 @@ func ParsePermissionId(ctx context.Context, id string) (permission Permission) {
 		return
 	}
 
+	if len(s) == 5 {
+		minor_id, err = strconv.ParseInt(s[4], 10, 64)
+		if err != nil {
+			logging.AddError(ctx, "Invalid id", "Unable to parse permission id")
+			return
+		}
+	}
+
 	permission.ScopeId = scope_id
+	permission.MinorId = minor_id
 	permission.PrincipalId = principal_id
 	permission.Permission = s[1]
 	permission.ScopeType = s[2]
@@ -94,21 +109,36 @@ func objectFormatId(ctx context.Context, connectionId string, objectId int64, ob
 	if objectType == "P" {
 		return procedureFormatId(connectionId, objectId)
 	}
+	if objectType == "SO" {
+		return sequenceFormatId(connectionId, objectId)
+	}
+	if objectType == "SN" {
+		return synonymFormatId(connectionId, objectId)
+	}
+	if objectType == "ET" {
+		return externalTableFormatId(connectionId, objectId)
+	}
 
 	logging.AddError(ctx, "Unrecognized object type", fmt.Sprintf("Unexpected object type %s found", objectType))
 	return ""
 }
 
-func scopeFormatId(ctx context.Context, connection Connection, scopeId int64, scopeType string) string {
+func scopeFormatId(ctx context.Context, connection Connection, scopeId int64, minorId int64, scopeType string) string {
 	if scopeType == "database" || scopeType == "server" {
 		return connection.ConnectionId
 	}
+	if scopeType == "column" {
+		return columnFormatId(connection.ConnectionId, scopeId, minorId)
+	}
 	if scopeType == "schema" {
 		return schemaFormatId(connection.ConnectionId, scopeId)
 	}
 	if scopeType == "databasescopedcredential" {
 		return databaseScopedCredentialFormatId(connection.ConnectionId, scopeId)
 	}
+	if scopeType == "type" {
+		return typeFormatId(connection.ConnectionId, scopeId)
+	}
 	if scopeType == "object" {
 		var objectType string
 		query := "select type from sys.objects where object_id = @object_id"
@@ -152,6 +182,18 @@ func GetScopeFromId(ctx context.Context, connection Connection, scopeResourceId
 			Id:           0,
 		}
 	}
+	if isColumnId(scopeResourceId) {
+		column := GetColumnFromId(ctx, connection, scopeResourceId, requiresExist)
+		if column.Id == "" {
+			return
+		}
+		return Scope{
+			ResourceType: "column",
+			Name:         fmt.Sprintf("%s.%s(%s)", column.SchemaName, column.TableName, quoteIdentifier(column.Name)),
+			Id:           column.ObjectId,
+			MinorId:      column.ColumnId,
+		}
+	}
 	if isSchemaId(scopeResourceId) {
 		schema := GetSchemaFromId(ctx, connection, scopeResourceId, requiresExist)
 		if schema.Id == "" {
@@ -210,6 +252,54 @@ func GetScopeFromId(ctx context.Context, connection Connection, scopeResourceId
 			Id:           procedure.ObjectId,
 		}
 	}
+	if isSequenceId(scopeResourceId) {
+		sequence := GetSequenceFromId(ctx, connection, scopeResourceId, requiresExist)
+		if sequence.Id == "" {
+			return
+		}
+		schema := GetSchemaFromId(ctx, connection, sequence.Schema, true)
+		return Scope{
+			ResourceType: "object",
+			Name:         fmt.Sprintf("%s.%s", schema.Name, sequence.Name),
+			Id:           sequence.ObjectId,
+		}
+	}
+	if isSynonymId(scopeResourceId) {
+		synonym := GetSynonymFromId(ctx, connection, scopeResourceId, requiresExist)
+		if synonym.Id == "" {
+			return
+		}
+		schema := GetSchemaFromId(ctx, connection, synonym.Schema, true)
+		return Scope{
+			ResourceType: "object",
+			Name:         fmt.Sprintf("%s.%s", schema.Name, synonym.Name),
+			Id:           synonym.ObjectId,
+		}
+	}
+	if isExternalTableId(scopeResourceId) {
+		externalTable := GetExternalTableFromId(ctx, connection, scopeResourceId, requiresExist)
+		if externalTable.Id == "" {
+			return
+		}
+		schema := GetSchemaFromId(ctx, connection, externalTable.Schema, true)
+		return Scope{
+			ResourceType: "object",
+			Name:         fmt.Sprintf("%s.%s", schema.Name, externalTable.Name),
+			Id:           externalTable.ObjectId,
+		}
+	}
+	if isTypeId(scopeResourceId) {
+		userType := GetTypeFromId(ctx, connection, scopeResourceId, requiresExist)
+		if userType.Id == "" {
+			return
+		}
+		schema := GetSchemaFromId(ctx, connection, userType.Schema, true)
+		return Scope{
+			ResourceType: "type",
+			Name:         fmt.Sprintf("%s.%s", schema.Name, userType.Name),
+			Id:           userType.TypeId,
+		}
+	}
 	if isDatabaseScopedCredentialId(scopeResourceId) {
 		databaseScopedCredential := GetDatabaseScopedCredentialFromId(ctx, connection, scopeResourceId, requiresExist)
 		if databaseScopedCredential.Id == "" {
@@ -240,12 +330,14 @@ func CreatePermission(ctx context.Context, connection Connection, scopeResourceI
 	var query string
 	if scope.ResourceType == "schema" {
 		query = fmt.Sprintf("%s %s on schema::%s to [%s]", action, permissionName, scope.Name, principal.Name)
-	} else if scope.ResourceType == "object" {
+	} else if scope.ResourceType == "object" || scope.ResourceType == "column" {
 		query = fmt.Sprintf("%s %s on object::%s to [%s]", action, permissionName, scope.Name, principal.Name)
 	} else if scope.ResourceType == "database" || scope.ResourceType == "server" {
 		query = fmt.Sprintf("%s %s to [%s]", action, permissionName, principal.Name)
 	} else if scope.ResourceType == "databasescopedcredential" {
 		query = fmt.Sprintf("%s %s on database scoped credential::%s to [%s]", action, permissionName, scope.Name, principal.Name)
+	} else if scope.ResourceType == "type" {
+		query = fmt.Sprintf("%s %s on type::%s to [%s]", action, permissionName, scope.Name, principal.Name)
 	} else {
 		logging.AddError(ctx, "Unrecognized scope", fmt.Sprintf("Unrecognized scope.resourceType %s", scope.ResourceType))
 		return
@@ -258,10 +350,11 @@ func CreatePermission(ctx context.Context, connection Connection, scopeResourceI
 	}
 
 	return Permission{
-		Id:          permissionFormatId(connection.ConnectionId, principal.PrincipalId, permissionName, scope.ResourceType, scope.Id),
+		Id:          permissionFormatId(connection.ConnectionId, principal.PrincipalId, permissionName, scope.ResourceType, scope.Id, scope.MinorId),
 		Connection:  connection.ConnectionId,
 		Scope:       scopeResourceId,
 		ScopeId:     scope.Id,
+		MinorId:     scope.MinorId,
 		Principal:   principalResourceId,
 		PrincipalId: principal.PrincipalId,
 		Permission:  permissionName,
@@ -284,10 +377,10 @@ func GetAllPermissions(ctx context.Context, connection Connection, scopeResource
 
 	query := `
 		select permission_name from sys.database_permissions 
-		where major_id = @scope_id and grantee_principal_id=@principal_id 
+		where major_id = @scope_id and minor_id = @minor_id and grantee_principal_id=@principal_id 
 		and state = 'G'`
 
-	rows, err := connection.Connection.QueryContext(ctx, query, sql.Named("scope_id", scope.Id),
+	rows, err := connection.Connection.QueryContext(ctx, query, sql.Named("scope_id", scope.Id), sql.Named("minor_id", scope.MinorId),
 		sql.Named("principal_id", principal.PrincipalId))
 	if err != nil {
 		logging.AddError(ctx, fmt.Sprintf("Failed to retrieve permissions for %s on %s", scope.Name, principal.Name), err)
@@ -326,13 +419,13 @@ func GetPermissionFromId(ctx context.Context, connection Connection, permissionR
 		select principals.type, permissions.state from sys.database_permissions permissions
 		left join sys.database_principals principals
 		on permissions.grantee_principal_id = principals.principal_id
-		where permissions.major_id = @scope_id and permissions.grantee_principal_id=@principal_id
+		where permissions.major_id = @scope_id and permissions.minor_id = @minor_id and permissions.grantee_principal_id=@principal_id
 		and upper(permissions.permission_name) = upper(@permission_name)
 		`
 
 	err := (connection.
 		Connection.
-		QueryRowContext(ctx, query, sql.Named("scope_id", permission.ScopeId), sql.Named("principal_id", permission.PrincipalId), sql.Named("permission_name", permission.Permission)).
+		QueryRowContext(ctx, query, sql.Named("scope_id", permission.ScopeId), sql.Named("minor_id", permission.MinorId), sql.Named("principal_id", permission.PrincipalId), sql.Named("permission_name", permission.Permission)).
 		Scan(&principalType, &state))
 
 	switch {
@@ -351,8 +444,9 @@ func GetPermissionFromId(ctx context.Context, connection Connection, permissionR
 	return Permission{
 		Id:          permissionResourceId,
 		Connection:  connection.ConnectionId,
-		Scope:       scopeFormatId(ctx, connection, permission.ScopeId, permission.ScopeType),
+		Scope:       scopeFormatId(ctx, connection, permission.ScopeId, permission.MinorId, permission.ScopeType),
 		ScopeId:     permission.ScopeId,
+		MinorId:     permission.MinorId,
 		Principal:   principalFormatId(connection.ConnectionId, permission.PrincipalId, principalType),
 		PrincipalId: permission.PrincipalId,
 		Permission:  permission.Permission,
@@ -376,12 +470,14 @@ func DropPermission(ctx context.Context, connection Connection, scopeResourceId
 	var query string
 	if scope.ResourceType == "schema" {
 		query = fmt.Sprintf("revoke %s on schema::%s to [%s]", permissionName, scope.Name, principal.Name)
-	} else if scope.ResourceType == "object" {
+	} else if scope.ResourceType == "object" || scope.ResourceType == "column" {
 		query = fmt.Sprintf("revoke %s on object::%s to [%s]", permissionName, scope.Name, principal.Name)
 	} else if scope.ResourceType == "database" || scope.ResourceType == "server" {
 		query = fmt.Sprintf("revoke %s to [%s]", permissionName, principal.Name)
 	} else if scope.ResourceType == "databasescopedcredential" {
 		query = fmt.Sprintf("revoke %s on database scoped credential::%s to [%s]", permissionName, scope.Name, principal.Name)
+	} else if scope.ResourceType == "type" {
+		query = fmt.Sprintf("revoke %s on type::%s to [%s]", permissionName, scope.Name, principal.Name)
 	} else {
 		logging.AddError(ctx, "Unrecognized scope", fmt.Sprintf("Unrecognized scope.resourceType %s", scope.ResourceType))
 		return
@@ -2,6 +2,7 @@ package sql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"terraform-provider-azuresql/internal/logging"
 )
@@ -52,3 +53,114 @@ func GetPrincipalFromId(ctx context.Context, connection Connection, id string, r
 		return
 	}
 }
+
+// prepareDropPrincipal prepares a user or role which still owns objects or has members to be dropped,
+// depending on onDestroy:
+//   - fail: nothing is changed, dropping fails when the principal owns objects or has members
+//   - reassign_owned_to: everything owned by the principal is transferred to reassignOwnedTo
+//   - remove_members: all members are removed from the role, and owned objects are transferred
+//     as well when reassignOwnedTo is set
+func prepareDropPrincipal(ctx context.Context, connection Connection, principal Principal, onDestroy string, reassignOwnedTo string) {
+	if onDestroy != "reassign_owned_to" && onDestroy != "remove_members" {
+		return
+	}
+
+	if reassignOwnedTo != "" {
+		target := GetPrincipalFromId(ctx, connection, reassignOwnedTo, true)
+		if logging.HasError(ctx) {
+			return
+		}
+		reassignOwned(ctx, connection, principal, target)
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+
+	if onDestroy == "remove_members" {
+		removeRoleMembers(ctx, connection, principal)
+	}
+}
+
+// Objects, types and xml schema collections inheriting the owner of their schema have no principal_id
+// and are transferred with the schema. Table types are transferred as types, not by their TT object.
+const ownedQuery = `
+	select 'schema' as class, quotename(name) as name
+	from sys.schemas
+	where principal_id = @principal_id
+	union all
+	select 'object', quotename(schema_name(schema_id)) + '.' + quotename(name)
+	from sys.objects
+	where principal_id = @principal_id and type <> 'TT'
+	union all
+	select 'type', quotename(schema_name(schema_id)) + '.' + quotename(name)
+	from sys.types
+	where is_user_defined = 1 and principal_id = @principal_id
+	union all
+	select 'xml schema collection', quotename(schema_name(schema_id)) + '.' + quotename(name)
+	from sys.xml_schema_collections
+	where principal_id = @principal_id
+	union all
+	select 'role', quotename(name)
+	from sys.database_principals
+	where type = 'R' and owning_principal_id = @principal_id`
+
+func reassignOwned(ctx context.Context, connection Connection, principal Principal, target Principal) {
+	rows, err := connection.Connection.QueryContext(ctx, ownedQuery, sql.Named("principal_id", principal.PrincipalId))
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading objects owned by %s failed", principal.Name), err)
+		return
+	}
+
+	var queries []string
+	for rows.Next() {
+		var class, name string
+		if err := rows.Scan(&class, &name); err != nil {
+			rows.Close()
+			logging.AddError(ctx, fmt.Sprintf("Reading objects owned by %s failed", principal.Name), err)
+			return
+		}
+		queries = append(queries, fmt.Sprintf("alter authorization on %s::%s to %s", class, name, quoteIdentifier(target.Name)))
+	}
+	rows.Close()
+
+	for _, query := range queries {
+		if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Reassigning objects owned by %s to %s failed", principal.Name, target.Name), err)
+			return
+		}
+	}
+}
+
+func removeRoleMembers(ctx context.Context, connection Connection, role Principal) {
+	query := `
+		select member.name
+		from sys.database_role_members members
+		inner join sys.database_principals member on member.principal_id = members.member_principal_id
+		where members.role_principal_id = @principal_id`
+
+	rows, err := connection.Connection.QueryContext(ctx, query, sql.Named("principal_id", role.PrincipalId))
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading members of role %s failed", role.Name), err)
+		return
+	}
+
+	var members []string
+	for rows.Next() {
+		var member string
+		if err := rows.Scan(&member); err != nil {
+			rows.Close()
+			logging.AddError(ctx, fmt.Sprintf("Reading members of role %s failed", role.Name), err)
+			return
+		}
+		members = append(members, member)
+	}
+	rows.Close()
+
+	for _, member := range members {
+		query := fmt.Sprintf("alter role %s drop member %s", quoteIdentifier(role.Name), quoteIdentifier(member))
+		if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Removing member %s from role %s failed", member, role.Name), err)
+			return
+		}
+	}
+}
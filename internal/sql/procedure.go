@@ -14,8 +14,11 @@ import (
 )
 
 type ProcedureArgument struct {
-	Name string
-	Type string
+	Name     string
+	Type     string
+	Default  string
+	Output   bool
+	Readonly bool
 }
 
 type ProcedureProps struct {
@@ -97,6 +100,22 @@ func CreateProcedureFromRaw(ctx context.Context, connection Connection, name str
 	return procedure
 }
 
+// Parameter declaration of a procedure or function, e.g. `@ids dbo.IdList readonly`.
+// The default is a T-SQL expression and is added as is.
+func formatArgument(name string, argumentType string, defaultValue string, output bool, readonly bool) string {
+	argument := "@" + name + " " + argumentType
+	if defaultValue != "" {
+		argument += " = " + defaultValue
+	}
+	if output {
+		argument += " output"
+	}
+	if readonly {
+		argument += " readonly"
+	}
+	return argument
+}
+
 func buildProcedureQuery(name string, schemaName string, props ProcedureProps) string {
 
 	arguments := ""
@@ -104,7 +123,7 @@ func buildProcedureQuery(name string, schemaName string, props ProcedureProps) s
 		if index != 0 {
 			arguments += ", "
 		}
-		arguments += "@" + argument.Name + " " + argument.Type
+		arguments += formatArgument(argument.Name, argument.Type, argument.Default, argument.Output, argument.Readonly)
 	}
 
 	var execute_as string
@@ -157,6 +176,25 @@ func CreateProcedureFromProperties(ctx context.Context, connection Connection, n
 	return CreateProcedureFromRaw(ctx, connection, name, schemaResourceId, query)
 }
 
+// Alters the procedure to the given properties, e.g. to update the definition in sys.sql_modules after a rename.
+func AlterProcedureFromProperties(ctx context.Context, connection Connection, id string, props ProcedureProps) {
+	procedure := GetProcedureFromId(ctx, connection, id, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	schema := GetSchemaFromId(ctx, connection, procedure.Schema, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := strings.Replace(buildProcedureQuery(procedure.Name, schema.Name, props), "create procedure", "alter procedure", 1)
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Altering procedure %s.%s failed", schema.Name, procedure.Name), err)
+	}
+}
+
 func GetProcedureFromNameAndSchema(ctx context.Context, connection Connection, name string, schemaResourceId string, requiresExist bool) (procedure Procedure) {
 	schema := ParseSchemaId(ctx, schemaResourceId)
 
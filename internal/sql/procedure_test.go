@@ -0,0 +1,13 @@
+package sql
+
+import "testing"
+
+func TestFormatArgument(t *testing.T) {
+	if argument := formatArgument("rows", "dbo.lines", "", false, true); argument != "@rows dbo.lines readonly" {
+		t.Errorf("Unexpected argument %s", argument)
+	}
+
+	if argument := formatArgument("total", "int", "0", true, false); argument != "@total int = 0 output" {
+		t.Errorf("Unexpected argument %s", argument)
+	}
+}
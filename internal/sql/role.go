@@ -236,7 +236,7 @@ func UpdateRoleOwner(ctx context.Context, connection Connection, id string, owne
 	}
 }
 
-func DropRole(ctx context.Context, connection Connection, principalId int64) {
+func DropRole(ctx context.Context, connection Connection, principalId int64, onDestroy string, reassignOwnedTo string) {
 
 	tflog.Info(ctx, fmt.Sprintf("Dropping role %d", principalId))
 
@@ -245,6 +245,11 @@ func DropRole(ctx context.Context, connection Connection, principalId int64) {
 		return
 	}
 
+	prepareDropPrincipal(ctx, connection, Principal{Name: role.Name, PrincipalId: role.PrincipalId}, onDestroy, reassignOwnedTo)
+	if logging.HasError(ctx) {
+		return
+	}
+
 	query := fmt.Sprintf(`
 		IF EXISTS (SELECT 1 FROM sys.database_principals WHERE name = '%[1]s')
 		BEGIN
@@ -184,13 +184,21 @@ func UpdateSchemaOwner(ctx context.Context, connection Connection, id string, ow
 	}
 }
 
-func DropSchema(ctx context.Context, connection Connection, schemaId int64) {
+// With forceDestroy the contained objects, types and xml schema collections are dropped first.
+func DropSchema(ctx context.Context, connection Connection, schemaId int64, forceDestroy bool) {
 
 	schema := GetSchemaFromSchemaId(ctx, connection, schemaId, false)
 	if logging.HasError(ctx) || schema.Id == "" {
 		return
 	}
 
+	if forceDestroy {
+		dropSchemaContent(ctx, connection, schema)
+		if logging.HasError(ctx) {
+			return
+		}
+	}
+
 	query := fmt.Sprintf(`
 		IF SCHEMA_ID('%[1]s') IS NOT NULL
 		BEGIN
@@ -205,3 +213,414 @@ func DropSchema(ctx context.Context, connection Connection, schemaId int64) {
 		logging.AddError(ctx, fmt.Sprintf("Dropping schema %s failed", schema.Name), err)
 	}
 }
+
+// Permission granted or denied on a schema
+type schemaPermission struct {
+	State      string
+	Permission string
+	Grantee    string
+}
+
+// Securables contained in a schema, by their transfer class
+type schemaContent struct {
+	Objects              []string
+	Types                []string
+	TableTypes           []string
+	XmlSchemaCollections []string
+	ForeignKeys          []schemaForeignKey
+}
+
+// Foreign key on a table in a schema, which is dropped before any table
+type schemaForeignKey struct {
+	Table string
+	Name  string
+}
+
+// Schemas can't be renamed, so a new schema is created with the owner and permissions of the old schema.
+// The securables are transferred to the new schema, which keeps their object ids, before the old schema is dropped.
+func buildRenameSchemaQueries(schemaName string, name string, ownerName string, permissions []schemaPermission, content schemaContent) (queries []string) {
+	queries = append(queries, fmt.Sprintf("create schema %s authorization %s", quoteIdentifier(name), quoteIdentifier(ownerName)))
+
+	for _, permission := range permissions {
+		switch permission.State {
+		case "D":
+			queries = append(queries, fmt.Sprintf("deny %s on schema::%s to %s", permission.Permission, quoteIdentifier(name), quoteIdentifier(permission.Grantee)))
+		case "W":
+			queries = append(queries, fmt.Sprintf("grant %s on schema::%s to %s with grant option", permission.Permission, quoteIdentifier(name), quoteIdentifier(permission.Grantee)))
+		default:
+			queries = append(queries, fmt.Sprintf("grant %s on schema::%s to %s", permission.Permission, quoteIdentifier(name), quoteIdentifier(permission.Grantee)))
+		}
+	}
+
+	transfer := func(class string, securables []string) {
+		for _, securable := range securables {
+			queries = append(queries, fmt.Sprintf("alter schema %s transfer %s::%s.%s", quoteIdentifier(name), class, quoteIdentifier(schemaName), quoteIdentifier(securable)))
+		}
+	}
+	transfer("type", content.TableTypes)
+	transfer("type", content.Types)
+	transfer("xml schema collection", content.XmlSchemaCollections)
+	transfer("object", content.Objects)
+
+	queries = append(queries, fmt.Sprintf("drop schema %s", quoteIdentifier(schemaName)))
+
+	return
+}
+
+func queryNames(ctx context.Context, connection Connection, query string, args ...any) (names []string) {
+	rows, err := connection.Connection.QueryContext(ctx, query, args...)
+	if err != nil {
+		logging.AddError(ctx, "Reading schema content failed", err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			logging.AddError(ctx, "Reading schema content failed", err)
+			return
+		}
+		names = append(names, name)
+	}
+	return
+}
+
+func RenameSchema(ctx context.Context, connection Connection, id string, name string) (schema Schema) {
+	schema = GetSchemaFromId(ctx, connection, id, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	owner := GetPrincipalFromId(ctx, connection, schema.Owner, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	var permissions []schemaPermission
+	query := `
+		select perms.state, perms.permission_name, grantee.name
+		from sys.database_permissions perms
+		inner join sys.database_principals grantee on grantee.principal_id = perms.grantee_principal_id
+		where perms.class = 3 and perms.major_id = @schema_id`
+
+	rows, err := connection.Connection.QueryContext(ctx, query, sql.Named("schema_id", schema.SchemaId))
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading permissions of schema %s failed", schema.Name), err)
+		return
+	}
+	for rows.Next() {
+		var permission schemaPermission
+		if err := rows.Scan(&permission.State, &permission.Permission, &permission.Grantee); err != nil {
+			rows.Close()
+			logging.AddError(ctx, fmt.Sprintf("Reading permissions of schema %s failed", schema.Name), err)
+			return
+		}
+		permissions = append(permissions, permission)
+	}
+	rows.Close()
+
+	// constraints, triggers and indexes are transferred with their parent object, table types as types
+	var content schemaContent
+	schemaId := sql.Named("schema_id", schema.SchemaId)
+	content.Objects = queryNames(ctx, connection, "select name from sys.objects where schema_id = @schema_id and parent_object_id = 0 and is_ms_shipped = 0 and type <> 'TT'", schemaId)
+	content.TableTypes = queryNames(ctx, connection, "select name from sys.types where schema_id = @schema_id and is_user_defined = 1 and is_table_type = 1", schemaId)
+	content.Types = queryNames(ctx, connection, "select name from sys.types where schema_id = @schema_id and is_user_defined = 1 and is_table_type = 0", schemaId)
+	content.XmlSchemaCollections = queryNames(ctx, connection, "select name from sys.xml_schema_collections where schema_id = @schema_id", schemaId)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	tx, err := connection.Connection.BeginTx(ctx, nil)
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Starting transaction to rename schema %s failed", schema.Name), err)
+		return
+	}
+
+	for _, query := range buildRenameSchemaQueries(schema.Name, name, owner.Name, permissions, content) {
+		if _, err = tx.ExecContext(ctx, query); err != nil {
+			tx.Rollback()
+			logging.AddError(ctx, fmt.Sprintf("Renaming schema %s to %s failed", schema.Name, name), err)
+			return
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Committing rename of schema %s failed", schema.Name), err)
+		return
+	}
+
+	schema = GetSchemaFromName(ctx, connection, name, false)
+	if !logging.HasError(ctx) && schema.Id == "" {
+		logging.AddError(ctx, "Unable to read renamed schema", fmt.Sprintf("Unable to read schema %s after renaming.", name))
+	}
+
+	return
+}
+
+// Object in a schema, which is dropped with force_destroy
+type schemaObject struct {
+	ObjectId int64
+	Name     string
+	Type     string
+}
+
+// Object referencing another object in the same schema
+type schemaDependency struct {
+	Referencing int64
+	Referenced  int64
+}
+
+// Drop statements by sys.objects type. Constraints, triggers and indexes are dropped with their parent object.
+// Objects of any other type fail force_destroy before anything is dropped.
+var schemaObjectDropStatements = map[string]string{
+	"AF": "aggregate",
+	"SQ": "queue",
+	"R":  "rule",
+	"D":  "default",
+	"U":  "table",
+	"V":  "view",
+	"P":  "procedure",
+	"PC": "procedure",
+	"FN": "function",
+	"IF": "function",
+	"TF": "function",
+	"FS": "function",
+	"FT": "function",
+	"SO": "sequence",
+	"SN": "synonym",
+	"ET": "external table",
+	"SP": "security policy",
+}
+
+// Objects are dropped before the objects they reference. Remaining objects with circular
+// dependencies are dropped in their original order.
+func orderSchemaObjectsForDrop(objects []schemaObject, dependencies []schemaDependency) (ordered []schemaObject) {
+	referencedBy := map[int64]int{}
+	references := map[int64][]int64{}
+	for _, dependency := range dependencies {
+		if dependency.Referencing == dependency.Referenced {
+			continue
+		}
+		referencedBy[dependency.Referenced]++
+		references[dependency.Referencing] = append(references[dependency.Referencing], dependency.Referenced)
+	}
+
+	dropped := map[int64]bool{}
+	for len(ordered) < len(objects) {
+		progress := false
+		for _, object := range objects {
+			if dropped[object.ObjectId] || referencedBy[object.ObjectId] > 0 {
+				continue
+			}
+			ordered = append(ordered, object)
+			dropped[object.ObjectId] = true
+			progress = true
+			for _, referenced := range references[object.ObjectId] {
+				referencedBy[referenced]--
+			}
+		}
+
+		if !progress {
+			for _, object := range objects {
+				if !dropped[object.ObjectId] {
+					ordered = append(ordered, object)
+					dropped[object.ObjectId] = true
+				}
+			}
+		}
+	}
+
+	return
+}
+
+func buildDropSchemaContentQueries(schemaName string, objects []schemaObject, dependencies []schemaDependency, content schemaContent) (queries []string) {
+	// dropping all foreign keys first allows tables referencing each other to be dropped
+	for _, foreignKey := range content.ForeignKeys {
+		queries = append(queries, fmt.Sprintf("alter table %s.%s drop constraint %s", quoteIdentifier(schemaName), quoteIdentifier(foreignKey.Table), quoteIdentifier(foreignKey.Name)))
+	}
+
+	// rules and defaults may still be bound to the types, so they are dropped last
+	var bound []schemaObject
+	for _, object := range orderSchemaObjectsForDrop(objects, dependencies) {
+		if object.Type == "R" || object.Type == "D" {
+			bound = append(bound, object)
+			continue
+		}
+		queries = append(queries, fmt.Sprintf("drop %s %s.%s", schemaObjectDropStatements[object.Type], quoteIdentifier(schemaName), quoteIdentifier(object.Name)))
+	}
+
+	// types and xml schema collections can only be dropped once no object uses them,
+	// table types first as their columns may use alias types of the schema
+	for _, name := range content.TableTypes {
+		queries = append(queries, fmt.Sprintf("drop type %s.%s", quoteIdentifier(schemaName), quoteIdentifier(name)))
+	}
+	for _, name := range content.Types {
+		queries = append(queries, fmt.Sprintf("drop type %s.%s", quoteIdentifier(schemaName), quoteIdentifier(name)))
+	}
+	for _, name := range content.XmlSchemaCollections {
+		queries = append(queries, fmt.Sprintf("drop xml schema collection %s.%s", quoteIdentifier(schemaName), quoteIdentifier(name)))
+	}
+
+	for _, object := range bound {
+		queries = append(queries, fmt.Sprintf("drop %s %s.%s", schemaObjectDropStatements[object.Type], quoteIdentifier(schemaName), quoteIdentifier(object.Name)))
+	}
+
+	return
+}
+
+// Dependencies of constraints and computed columns are attributed to their table.
+// Foreign keys aren't dependencies, as they are all dropped before the tables.
+const schemaDependencyQuery = `
+	select coalesce(nullif(referencing.parent_object_id, 0), referencing.object_id), referenced.object_id
+	from sys.sql_expression_dependencies dependencies
+	inner join sys.objects referencing on referencing.object_id = dependencies.referencing_id
+	inner join sys.objects referenced on referenced.object_id = dependencies.referenced_id
+	where referencing.schema_id = @schema_id and referenced.schema_id = @schema_id`
+
+// Objects in other schemas which prevent the content of the schema from being dropped: schema bound
+// references, foreign keys, and columns or parameters using types of the schema.
+const schemaExternalReferenceQuery = `
+	select distinct quotename(object_schema_name(dependent.object_id)) + '.' + quotename(object_name(dependent.object_id))
+	from (
+		select coalesce(nullif(referencing.parent_object_id, 0), referencing.object_id) as object_id, referencing.schema_id
+		from sys.sql_expression_dependencies dependencies
+		inner join sys.objects referencing on referencing.object_id = dependencies.referencing_id
+		left join sys.objects referenced on dependencies.referenced_class = 1 and referenced.object_id = dependencies.referenced_id
+		left join sys.types referenced_type on dependencies.referenced_class = 6 and referenced_type.user_type_id = dependencies.referenced_id
+		where dependencies.is_schema_bound_reference = 1 and coalesce(referenced.schema_id, referenced_type.schema_id) = @schema_id
+		union all
+		select fk.parent_object_id, fk.schema_id
+		from sys.foreign_keys fk
+		inner join sys.objects referenced on referenced.object_id = fk.referenced_object_id
+		where referenced.schema_id = @schema_id
+		union all
+		select parent.object_id, parent.schema_id
+		from sys.columns columns
+		inner join sys.objects parent on parent.object_id = columns.object_id
+		inner join sys.types types on types.user_type_id = columns.user_type_id
+		where types.is_user_defined = 1 and types.schema_id = @schema_id
+		union all
+		select parent.object_id, parent.schema_id
+		from sys.parameters parameters
+		inner join sys.objects parent on parent.object_id = parameters.object_id
+		inner join sys.types types on types.user_type_id = parameters.user_type_id
+		where types.is_user_defined = 1 and types.schema_id = @schema_id
+	) dependent
+	where dependent.schema_id <> @schema_id`
+
+func dropSchemaContent(ctx context.Context, connection Connection, schema Schema) {
+	schemaId := sql.Named("schema_id", schema.SchemaId)
+
+	// table types are dropped as types
+	query := `
+		select object_id, name, rtrim(type)
+		from sys.objects
+		where schema_id = @schema_id and parent_object_id = 0 and is_ms_shipped = 0 and type <> 'TT'
+		order by object_id`
+
+	rows, err := connection.Connection.QueryContext(ctx, query, schemaId)
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading objects of schema %s failed", schema.Name), err)
+		return
+	}
+
+	var objects []schemaObject
+	for rows.Next() {
+		var object schemaObject
+		if err := rows.Scan(&object.ObjectId, &object.Name, &object.Type); err != nil {
+			rows.Close()
+			logging.AddError(ctx, fmt.Sprintf("Reading objects of schema %s failed", schema.Name), err)
+			return
+		}
+		objects = append(objects, object)
+	}
+	rows.Close()
+
+	var unsupported []string
+	for _, object := range objects {
+		if _, ok := schemaObjectDropStatements[object.Type]; !ok {
+			unsupported = append(unsupported, fmt.Sprintf("%s (%s)", quoteIdentifier(object.Name), object.Type))
+		}
+	}
+	if len(unsupported) > 0 {
+		logging.AddError(ctx, fmt.Sprintf("Dropping the content of schema %s failed", schema.Name),
+			fmt.Sprintf("force_destroy can't drop objects of these types, drop them first: %s", strings.Join(unsupported, ", ")))
+		return
+	}
+
+	referencing := queryNames(ctx, connection, schemaExternalReferenceQuery, schemaId)
+	if logging.HasError(ctx) {
+		return
+	}
+	if len(referencing) > 0 {
+		logging.AddError(ctx, fmt.Sprintf("Dropping the content of schema %s failed", schema.Name),
+			fmt.Sprintf("Objects in other schemas depend on schema %s, drop or alter them first: %s", schema.Name, strings.Join(referencing, ", ")))
+		return
+	}
+
+	rows, err = connection.Connection.QueryContext(ctx, schemaDependencyQuery, schemaId)
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading dependencies in schema %s failed", schema.Name), err)
+		return
+	}
+
+	var dependencies []schemaDependency
+	for rows.Next() {
+		var dependency schemaDependency
+		if err := rows.Scan(&dependency.Referencing, &dependency.Referenced); err != nil {
+			rows.Close()
+			logging.AddError(ctx, fmt.Sprintf("Reading dependencies in schema %s failed", schema.Name), err)
+			return
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	rows.Close()
+
+	var content schemaContent
+	content.TableTypes = queryNames(ctx, connection, "select name from sys.types where schema_id = @schema_id and is_user_defined = 1 and is_table_type = 1", schemaId)
+	content.Types = queryNames(ctx, connection, "select name from sys.types where schema_id = @schema_id and is_user_defined = 1 and is_table_type = 0", schemaId)
+	content.XmlSchemaCollections = queryNames(ctx, connection, "select name from sys.xml_schema_collections where schema_id = @schema_id", schemaId)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	rows, err = connection.Connection.QueryContext(ctx, `
+		select object_name(fk.parent_object_id), fk.name
+		from sys.foreign_keys fk
+		where fk.schema_id = @schema_id
+		order by fk.object_id`, schemaId)
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading foreign keys in schema %s failed", schema.Name), err)
+		return
+	}
+
+	for rows.Next() {
+		var foreignKey schemaForeignKey
+		if err := rows.Scan(&foreignKey.Table, &foreignKey.Name); err != nil {
+			rows.Close()
+			logging.AddError(ctx, fmt.Sprintf("Reading foreign keys in schema %s failed", schema.Name), err)
+			return
+		}
+		content.ForeignKeys = append(content.ForeignKeys, foreignKey)
+	}
+	rows.Close()
+
+	tx, err := connection.Connection.BeginTx(ctx, nil)
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Starting transaction to drop the content of schema %s failed", schema.Name), err)
+		return
+	}
+
+	for _, query := range buildDropSchemaContentQueries(schema.Name, objects, dependencies, content) {
+		if _, err = tx.ExecContext(ctx, query); err != nil {
+			tx.Rollback()
+			logging.AddError(ctx, fmt.Sprintf("Dropping the content of schema %s failed", schema.Name), err)
+			return
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Committing drop of the content of schema %s failed", schema.Name), err)
+	}
+}
@@ -0,0 +1,107 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildRenameSchemaQueries(t *testing.T) {
+	queries := buildRenameSchemaQueries("sales", "finance", "dbo",
+		[]schemaPermission{
+			{State: "G", Permission: "SELECT", Grantee: "reader"},
+			{State: "W", Permission: "EXECUTE", Grantee: "app"},
+			{State: "D", Permission: "DELETE", Grantee: "reader"},
+		},
+		schemaContent{
+			Objects:    []string{"orders"},
+			Types:      []string{"Amount"},
+			TableTypes: []string{"IdList"},
+		})
+
+	assert.Equal(t, []string{
+		"create schema [finance] authorization [dbo]",
+		"grant SELECT on schema::[finance] to [reader]",
+		"grant EXECUTE on schema::[finance] to [app] with grant option",
+		"deny DELETE on schema::[finance] to [reader]",
+		"alter schema [finance] transfer type::[sales].[IdList]",
+		"alter schema [finance] transfer type::[sales].[Amount]",
+		"alter schema [finance] transfer object::[sales].[orders]",
+		"drop schema [sales]",
+	}, queries)
+}
+
+func TestBuildDropSchemaContentQueries(t *testing.T) {
+	objects := []schemaObject{
+		{ObjectId: 1, Name: "customers", Type: "U"},
+		{ObjectId: 2, Name: "orders", Type: "U"},
+		{ObjectId: 3, Name: "order_totals", Type: "V"},
+		{ObjectId: 4, Name: "order_id", Type: "SO"},
+		{ObjectId: 5, Name: "get_totals", Type: "P"},
+		{ObjectId: 6, Name: "positive", Type: "R"},
+	}
+	dependencies := []schemaDependency{
+		{Referencing: 2, Referenced: 4}, // default constraint using the sequence
+		{Referencing: 3, Referenced: 2},
+		{Referencing: 3, Referenced: 2},
+		{Referencing: 5, Referenced: 3},
+	}
+
+	queries := buildDropSchemaContentQueries("sales", objects, dependencies, schemaContent{
+		Types:                []string{"Amount"},
+		TableTypes:           []string{"OrderLines"},
+		XmlSchemaCollections: []string{"Orders"},
+		ForeignKeys:          []schemaForeignKey{{Table: "orders", Name: "FK_orders_customers"}},
+	})
+
+	assert.Equal(t, []string{
+		"alter table [sales].[orders] drop constraint [FK_orders_customers]",
+		"drop table [sales].[customers]",
+		"drop procedure [sales].[get_totals]",
+		"drop view [sales].[order_totals]",
+		"drop table [sales].[orders]",
+		"drop sequence [sales].[order_id]",
+		"drop type [sales].[OrderLines]", // table type using the alias type Amount
+		"drop type [sales].[Amount]",
+		"drop xml schema collection [sales].[Orders]",
+		"drop rule [sales].[positive]",
+	}, queries)
+}
+
+func TestBuildDropSchemaContentQueriesWithForeignKeyCycle(t *testing.T) {
+	objects := []schemaObject{
+		{ObjectId: 1, Name: "employees", Type: "U"},
+		{ObjectId: 2, Name: "departments", Type: "U"},
+	}
+
+	queries := buildDropSchemaContentQueries("hr", objects, nil, schemaContent{
+		ForeignKeys: []schemaForeignKey{
+			{Table: "employees", Name: "FK_employees_departments"},
+			{Table: "departments", Name: "FK_departments_manager"},
+		},
+	})
+
+	assert.Equal(t, []string{
+		"alter table [hr].[employees] drop constraint [FK_employees_departments]",
+		"alter table [hr].[departments] drop constraint [FK_departments_manager]",
+		"drop table [hr].[employees]",
+		"drop table [hr].[departments]",
+	}, queries)
+}
+
+func TestOrderSchemaObjectsForDropWithCycle(t *testing.T) {
+	objects := []schemaObject{
+		{ObjectId: 1, Name: "a", Type: "P"},
+		{ObjectId: 2, Name: "b", Type: "P"},
+		{ObjectId: 3, Name: "c", Type: "V"},
+	}
+	dependencies := []schemaDependency{
+		{Referencing: 1, Referenced: 2},
+		{Referencing: 2, Referenced: 1},
+		{Referencing: 1, Referenced: 3},
+	}
+
+	ordered := orderSchemaObjectsForDrop(objects, dependencies)
+
+	assert.Equal(t, []string{"a", "b", "c"}, []string{ordered[0].Name, ordered[1].Name, ordered[2].Name})
+}
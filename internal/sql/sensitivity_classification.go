@@ -0,0 +1,183 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+// Sensitivity classification of a table column. Empty strings are not set.
+type SensitivityClassification struct {
+	Id                string
+	Connection        string
+	Table             string
+	Column            string
+	ObjectId          int64
+	ColumnId          int64
+	Label             string
+	LabelId           string
+	InformationType   string
+	InformationTypeId string
+	Rank              string
+}
+
+// The id extends the id of the table, so classifications can be defined alongside the table.
+func sensitivityClassificationFormatId(connectionId string, objectId int64, columnId int64) string {
+	return fmt.Sprintf("%s/sensitivityclassification/%d", tableFormatId(connectionId, objectId), columnId)
+}
+
+func ParseSensitivityClassificationId(ctx context.Context, id string) (classification SensitivityClassification) {
+	s := strings.Split(id, "/sensitivityclassification/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /sensitivityclassification/ exactly once")
+		return
+	}
+
+	table := parseTableId(ctx, s[0])
+	if logging.HasError(ctx) {
+		return
+	}
+
+	columnId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse sensitivity classification id")
+		return
+	}
+
+	classification.Connection = table.Connection
+	classification.Table = table.Id
+	classification.ObjectId = table.ObjectId
+	classification.ColumnId = columnId
+
+	return
+}
+
+func buildSensitivityClassificationQuery(column Column, classification SensitivityClassification) string {
+	var options []string
+	if classification.Label != "" {
+		options = append(options, "label = "+quoteString(classification.Label))
+	}
+	if classification.LabelId != "" {
+		options = append(options, "label_id = "+quoteString(classification.LabelId))
+	}
+	if classification.InformationType != "" {
+		options = append(options, "information_type = "+quoteString(classification.InformationType))
+	}
+	if classification.InformationTypeId != "" {
+		options = append(options, "information_type_id = "+quoteString(classification.InformationTypeId))
+	}
+	if classification.Rank != "" {
+		options = append(options, "rank = "+classification.Rank)
+	}
+
+	return fmt.Sprintf("add sensitivity classification to %s.%s.%s with (%s)",
+		quoteIdentifier(column.SchemaName), quoteIdentifier(column.TableName), quoteIdentifier(column.Name), strings.Join(options, ", "))
+}
+
+func CreateSensitivityClassification(ctx context.Context, connection Connection, classification SensitivityClassification) SensitivityClassification {
+
+	column := GetColumnFromName(ctx, connection, classification.Table, classification.Column, true)
+	if logging.HasError(ctx) {
+		return SensitivityClassification{}
+	}
+
+	id := sensitivityClassificationFormatId(connection.ConnectionId, column.ObjectId, column.ColumnId)
+
+	existing := GetSensitivityClassificationFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) {
+		return SensitivityClassification{}
+	}
+	if existing.Id != "" {
+		logging.AddError(ctx, "Sensitivity classification already exists", fmt.Sprintf("Column %s.%s.%s is already classified", column.SchemaName, column.TableName, column.Name))
+		return existing
+	}
+
+	if _, err := connection.Connection.ExecContext(ctx, buildSensitivityClassificationQuery(column, classification)); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Classifying column %s.%s.%s failed", column.SchemaName, column.TableName, column.Name), err)
+		return SensitivityClassification{}
+	}
+
+	classification = GetSensitivityClassificationFromId(ctx, connection, id, false)
+	if !logging.HasError(ctx) && classification.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created sensitivity classification", fmt.Sprintf("Unable to read sensitivity classification of column %s after creation.", column.Name))
+	}
+
+	return classification
+}
+
+func GetSensitivityClassificationFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (classification SensitivityClassification) {
+	classification = ParseSensitivityClassificationId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if classification.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	var label, labelId, informationType, informationTypeId, rank sql.NullString
+	query := `
+		select c.name, sc.label, convert(nvarchar(128), sc.label_id), sc.information_type, convert(nvarchar(128), sc.information_type_id), sc.rank_desc
+		from sys.sensitivity_classifications sc
+		inner join sys.columns c on c.object_id = sc.major_id and c.column_id = sc.minor_id
+		where sc.class = 1 and sc.major_id = @object_id and sc.minor_id = @column_id`
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("object_id", classification.ObjectId), sql.Named("column_id", classification.ColumnId)).
+		Scan(&classification.Column, &label, &labelId, &informationType, &informationTypeId, &rank)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Sensitivity classification not found", fmt.Sprintf("Sensitivity classification with id %s doesn't exist", id))
+		}
+		return SensitivityClassification{}
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading sensitivity classification %s failed", id), err)
+		return SensitivityClassification{}
+	}
+
+	classification.Id = sensitivityClassificationFormatId(connection.ConnectionId, classification.ObjectId, classification.ColumnId)
+	classification.Label = label.String
+	classification.LabelId = labelId.String
+	classification.InformationType = informationType.String
+	classification.InformationTypeId = informationTypeId.String
+	classification.Rank = rank.String
+
+	return
+}
+
+// Adding a classification to a classified column replaces the classification.
+func UpdateSensitivityClassification(ctx context.Context, connection Connection, classification SensitivityClassification) {
+	column := GetColumnFromId(ctx, connection, columnFormatId(connection.ConnectionId, classification.ObjectId, classification.ColumnId), true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if _, err := connection.Connection.ExecContext(ctx, buildSensitivityClassificationQuery(column, classification)); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Classifying column %s.%s.%s failed", column.SchemaName, column.TableName, column.Name), err)
+	}
+}
+
+func DropSensitivityClassification(ctx context.Context, connection Connection, id string) {
+
+	classification := GetSensitivityClassificationFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || classification.Id == "" {
+		return
+	}
+
+	column := GetColumnFromId(ctx, connection, columnFormatId(connection.ConnectionId, classification.ObjectId, classification.ColumnId), false)
+	if logging.HasError(ctx) || column.Id == "" {
+		return
+	}
+
+	query := fmt.Sprintf("drop sensitivity classification from %s.%s.%s",
+		quoteIdentifier(column.SchemaName), quoteIdentifier(column.TableName), quoteIdentifier(column.Name))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping sensitivity classification of column %s.%s.%s failed", column.SchemaName, column.TableName, column.Name), err)
+	}
+}
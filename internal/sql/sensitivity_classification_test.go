@@ -0,0 +1,35 @@
+package sql
+
+import (
+	"terraform-provider-azuresql/internal/logging"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSensitivityClassificationId(t *testing.T) {
+	ctx := logging.GetTestContext()
+	id := sensitivityClassificationFormatId("sqlserver::server:1433:db", 123, 2)
+	assert.Equal(t, "sqlserver::server:1433:db/table/123/sensitivityclassification/2", id)
+
+	classification := ParseSensitivityClassificationId(ctx, id)
+	assert.False(t, logging.HasError(ctx))
+	assert.Equal(t, "sqlserver::server:1433:db", classification.Connection)
+	assert.Equal(t, "sqlserver::server:1433:db/table/123", classification.Table)
+	assert.Equal(t, int64(123), classification.ObjectId)
+	assert.Equal(t, int64(2), classification.ColumnId)
+
+	ctx = logging.GetTestContext()
+	ParseSensitivityClassificationId(ctx, "sqlserver::server:1433:db/table/123/sensitivityclassification/email")
+	assert.True(t, logging.HasError(ctx))
+}
+
+func TestBuildSensitivityClassificationQuery(t *testing.T) {
+	column := Column{SchemaName: "dbo", TableName: "customers", Name: "email"}
+
+	query := buildSensitivityClassificationQuery(column, SensitivityClassification{Label: "Confidential", InformationType: "Contact Info", Rank: "MEDIUM"})
+	assert.Equal(t, "add sensitivity classification to [dbo].[customers].[email] with (label = 'Confidential', information_type = 'Contact Info', rank = MEDIUM)", query)
+
+	query = buildSensitivityClassificationQuery(column, SensitivityClassification{Label: "Highly Confidential", LabelId: "1866ca45-1973-4c28-9d12-04d407f147ad"})
+	assert.Equal(t, "add sensitivity classification to [dbo].[customers].[email] with (label = 'Highly Confidential', label_id = '1866ca45-1973-4c28-9d12-04d407f147ad')", query)
+}
@@ -0,0 +1,234 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+type Sequence struct {
+	Id           string
+	Connection   string
+	Name         string
+	ObjectId     int64
+	Schema       string
+	DataType     string
+	StartValue   int64
+	Increment    int64
+	MinValue     int64
+	MaxValue     int64
+	Cycle        bool
+	Cache        bool
+	CacheSize    sql.NullInt64
+	CurrentValue int64
+}
+
+func sequenceFormatId(connectionId string, objectId int64) string {
+	return fmt.Sprintf("%s/sequence/%d", connectionId, objectId)
+}
+
+func isSequenceId(id string) bool {
+	return strings.Contains(id, "/sequence/")
+}
+
+func ParseSequenceId(ctx context.Context, id string) (sequence Sequence) {
+	s := strings.Split(id, "/sequence/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /sequence/ exactly once")
+		return
+	}
+
+	sequence.Connection = s[0]
+
+	objectId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse sequence id")
+		return
+	}
+
+	sequence.ObjectId = objectId
+
+	return
+}
+
+// Options shared by CREATE and ALTER SEQUENCE.
+func sequenceOptions(sequence Sequence) string {
+	options := fmt.Sprintf("increment by %d minvalue %d maxvalue %d", sequence.Increment, sequence.MinValue, sequence.MaxValue)
+
+	if sequence.Cycle {
+		options += " cycle"
+	} else {
+		options += " no cycle"
+	}
+
+	if !sequence.Cache {
+		options += " no cache"
+	} else if sequence.CacheSize.Valid {
+		options += fmt.Sprintf(" cache %d", sequence.CacheSize.Int64)
+	} else {
+		options += " cache"
+	}
+
+	return options
+}
+
+func CreateSequence(ctx context.Context, connection Connection, name string, schemaResourceId string, sequence Sequence) Sequence {
+
+	schema := GetSchemaFromId(ctx, connection, schemaResourceId, true)
+
+	if logging.HasError(ctx) {
+		return Sequence{}
+	}
+
+	query := fmt.Sprintf("create sequence %s.%s as %s start with %d %s",
+		quoteIdentifier(schema.Name), quoteIdentifier(name), sequence.DataType, sequence.StartValue, sequenceOptions(sequence))
+
+	_, err := connection.Connection.ExecContext(ctx, query)
+
+	if err != nil {
+		logging.AddError(ctx, "Sequence creation failed", err)
+		return Sequence{}
+	}
+
+	sequence = GetSequenceFromNameAndSchema(ctx, connection, name, schemaResourceId, false)
+	if !logging.HasError(ctx) && sequence.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created sequence", fmt.Sprintf("Unable to read sequence %s after creation.", name))
+	}
+
+	return sequence
+}
+
+func GetSequenceFromNameAndSchema(ctx context.Context, connection Connection, name string, schemaResourceId string, requiresExist bool) (sequence Sequence) {
+	schema := ParseSchemaId(ctx, schemaResourceId)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	var objectId int64
+	query := "select object_id from sys.sequences where name = @name and schema_id = @schema_id"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name), sql.Named("schema_id", schema.SchemaId)).Scan(&objectId)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Sequence not found", fmt.Sprintf("Sequence with name %s doesn't exist", name))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading sequence %s failed", name), err)
+		return
+	}
+
+	return GetSequenceFromObjectId(ctx, connection, objectId, requiresExist)
+}
+
+func GetSequenceFromObjectId(ctx context.Context, connection Connection, objectId int64, requiresExist bool) (sequence Sequence) {
+	var schemaId int64
+
+	query := `
+		select name, schema_id, type_name(user_type_id),
+			convert(bigint, start_value), convert(bigint, increment),
+			convert(bigint, minimum_value), convert(bigint, maximum_value),
+			is_cycling, is_cached, cache_size, convert(bigint, current_value)
+		from sys.sequences
+		where object_id = @object_id`
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("object_id", objectId)).Scan(
+		&sequence.Name, &schemaId, &sequence.DataType,
+		&sequence.StartValue, &sequence.Increment,
+		&sequence.MinValue, &sequence.MaxValue,
+		&sequence.Cycle, &sequence.Cache, &sequence.CacheSize, &sequence.CurrentValue)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Sequence not found", fmt.Sprintf("Sequence with objectId %d doesn't exist", objectId))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading sequence %d failed", objectId), err)
+		return
+	}
+
+	sequence.Id = sequenceFormatId(connection.ConnectionId, objectId)
+	sequence.Connection = connection.ConnectionId
+	sequence.ObjectId = objectId
+	sequence.Schema = schemaFormatId(connection.ConnectionId, schemaId)
+
+	return
+}
+
+func GetSequenceFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (sequence Sequence) {
+	sequence = ParseSequenceId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if sequence.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	return GetSequenceFromObjectId(ctx, connection, sequence.ObjectId, requiresExist)
+}
+
+// Change the options of a sequence in place. When restart is set,
+// the sequence restarts with the start value of sequence.
+func UpdateSequence(ctx context.Context, connection Connection, id string, sequence Sequence, restart bool) {
+	current := GetSequenceFromId(ctx, connection, id, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	schema := GetSchemaFromId(ctx, connection, current.Schema, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := fmt.Sprintf("alter sequence %s.%s", quoteIdentifier(schema.Name), quoteIdentifier(current.Name))
+	if restart {
+		query += fmt.Sprintf(" restart with %d", sequence.StartValue)
+	}
+	query += " " + sequenceOptions(sequence)
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Updating sequence %s.%s failed", schema.Name, current.Name), err)
+	}
+}
+
+func DropSequence(ctx context.Context, connection Connection, id string) {
+
+	sequence := GetSequenceFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || sequence.Id == "" {
+		return
+	}
+	schema := GetSchemaFromId(ctx, connection, sequence.Schema, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := fmt.Sprintf("drop sequence %s.%s", quoteIdentifier(schema.Name), quoteIdentifier(sequence.Name))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping sequence %s.%s failed", schema.Name, sequence.Name), err)
+	}
+}
+
+// Range of the integer types supported for sequences. These are also the
+// default minimum and maximum values of a sequence.
+func SequenceTypeRange(dataType string) (min int64, max int64) {
+	switch strings.ToLower(dataType) {
+	case "tinyint":
+		return 0, math.MaxUint8
+	case "smallint":
+		return math.MinInt16, math.MaxInt16
+	case "int":
+		return math.MinInt32, math.MaxInt32
+	default:
+		return math.MinInt64, math.MaxInt64
+	}
+}
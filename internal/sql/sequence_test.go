@@ -0,0 +1,34 @@
+package sql
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestSequenceOptions(t *testing.T) {
+	sequence := Sequence{Increment: 5, MinValue: 1, MaxValue: 100, Cycle: true, Cache: true, CacheSize: sql.NullInt64{Int64: 10, Valid: true}}
+
+	options := sequenceOptions(sequence)
+	if options != "increment by 5 minvalue 1 maxvalue 100 cycle cache 10" {
+		t.Errorf("Unexpected sequence options %s", options)
+	}
+
+	sequence.Cycle = false
+	sequence.Cache = false
+	options = sequenceOptions(sequence)
+	if options != "increment by 5 minvalue 1 maxvalue 100 no cycle no cache" {
+		t.Errorf("Unexpected sequence options %s", options)
+	}
+}
+
+func TestSequenceTypeRange(t *testing.T) {
+	min, max := SequenceTypeRange("tinyint")
+	if min != 0 || max != 255 {
+		t.Errorf("Unexpected range for tinyint: %d - %d", min, max)
+	}
+
+	min, max = SequenceTypeRange("int")
+	if min != -2147483648 || max != 2147483647 {
+		t.Errorf("Unexpected range for int: %d - %d", min, max)
+	}
+}
@@ -0,0 +1,164 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+type Synonym struct {
+	Id         string
+	Connection string
+	Name       string
+	ObjectId   int64
+	Schema     string
+	BaseObject string
+}
+
+func synonymFormatId(connectionId string, objectId int64) string {
+	return fmt.Sprintf("%s/synonym/%d", connectionId, objectId)
+}
+
+func isSynonymId(id string) bool {
+	return strings.Contains(id, "/synonym/")
+}
+
+func ParseSynonymId(ctx context.Context, id string) (synonym Synonym) {
+	s := strings.Split(id, "/synonym/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /synonym/ exactly once")
+		return
+	}
+
+	synonym.Connection = s[0]
+
+	objectId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse synonym id")
+		return
+	}
+
+	synonym.ObjectId = objectId
+
+	return
+}
+
+func CreateSynonym(ctx context.Context, connection Connection, name string, schemaResourceId string, baseObject string) (synonym Synonym) {
+
+	schema := GetSchemaFromId(ctx, connection, schemaResourceId, true)
+
+	if logging.HasError(ctx) {
+		return
+	}
+
+	// the base object is a one to four part name, which is possibly already quoted
+	query := fmt.Sprintf("create synonym %s.%s for %s", quoteIdentifier(schema.Name), quoteIdentifier(name), baseObject)
+
+	_, err := connection.Connection.ExecContext(ctx, query)
+
+	if err != nil {
+		logging.AddError(ctx, "Synonym creation failed", err)
+		return
+	}
+
+	synonym = GetSynonymFromNameAndSchema(ctx, connection, name, schemaResourceId, false)
+	if !logging.HasError(ctx) && synonym.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created synonym", fmt.Sprintf("Unable to read synonym %s after creation.", name))
+	}
+
+	return synonym
+}
+
+// sys.synonyms returns the base object with every part quoted,
+// e.g. `dbo.mytable` is stored as `[dbo].[mytable]`.
+func IsSynonymBaseObjectEquivalent(baseObject1 string, baseObject2 string) bool {
+	normalize := func(name string) string {
+		return strings.ToLower(strings.NewReplacer("[", "", "]", "").Replace(strings.TrimSpace(name)))
+	}
+	return normalize(baseObject1) == normalize(baseObject2)
+}
+
+func GetSynonymFromNameAndSchema(ctx context.Context, connection Connection, name string, schemaResourceId string, requiresExist bool) (synonym Synonym) {
+	schema := ParseSchemaId(ctx, schemaResourceId)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	var objectId int64
+	query := "select object_id from sys.synonyms where name = @name and schema_id = @schema_id"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name), sql.Named("schema_id", schema.SchemaId)).Scan(&objectId)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Synonym not found", fmt.Sprintf("Synonym with name %s doesn't exist", name))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading synonym %s failed", name), err)
+		return
+	}
+
+	return GetSynonymFromObjectId(ctx, connection, objectId, requiresExist)
+}
+
+func GetSynonymFromObjectId(ctx context.Context, connection Connection, objectId int64, requiresExist bool) (synonym Synonym) {
+	var schemaId int64
+
+	query := "select name, schema_id, base_object_name from sys.synonyms where object_id = @object_id"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("object_id", objectId)).Scan(&synonym.Name, &schemaId, &synonym.BaseObject)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Synonym not found", fmt.Sprintf("Synonym with objectId %d doesn't exist", objectId))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading synonym %d failed", objectId), err)
+		return
+	}
+
+	synonym.Id = synonymFormatId(connection.ConnectionId, objectId)
+	synonym.Connection = connection.ConnectionId
+	synonym.ObjectId = objectId
+	synonym.Schema = schemaFormatId(connection.ConnectionId, schemaId)
+
+	return
+}
+
+func GetSynonymFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (synonym Synonym) {
+	synonym = ParseSynonymId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if synonym.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	return GetSynonymFromObjectId(ctx, connection, synonym.ObjectId, requiresExist)
+}
+
+func DropSynonym(ctx context.Context, connection Connection, id string) {
+
+	synonym := GetSynonymFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || synonym.Id == "" {
+		return
+	}
+	schema := GetSchemaFromId(ctx, connection, synonym.Schema, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := fmt.Sprintf("drop synonym %s.%s", quoteIdentifier(schema.Name), quoteIdentifier(synonym.Name))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping synonym %s.%s failed", schema.Name, synonym.Name), err)
+	}
+}
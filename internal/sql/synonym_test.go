@@ -0,0 +1,29 @@
+package sql
+
+import (
+	"terraform-provider-azuresql/internal/logging"
+	"testing"
+)
+
+func TestIsSynonymBaseObjectEquivalent(t *testing.T) {
+	if !IsSynonymBaseObjectEquivalent("otherdb.dbo.MyTable", "[otherdb].[dbo].[mytable]") {
+		t.Errorf("Quoted base object should be equivalent to the unquoted name")
+	}
+
+	if IsSynonymBaseObjectEquivalent("dbo.table1", "[dbo].[table2]") {
+		t.Errorf("Different base objects should not be equivalent")
+	}
+}
+
+func TestObjectFormatIdSynonym(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	id := objectFormatId(ctx, "sqlserver::server:1433:db", 1234, "SN")
+	if logging.HasError(ctx) {
+		t.Fatalf("Unexpected error for object type SN")
+	}
+
+	if id != "sqlserver::server:1433:db/synonym/1234" {
+		t.Errorf("Unexpected synonym id %s", id)
+	}
+}
@@ -0,0 +1,449 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+// Set of rows in a table, identified by the values of the key columns.
+type TableRows struct {
+	Id         string
+	Connection string
+	Table      string
+	ObjectId   int64
+	KeyColumns []string
+}
+
+// Values of a single row by column name. Invalid values represent NULL.
+type TableRow map[string]sql.NullString
+
+func tableRowsFormatId(connectionId string, objectId int64, keyColumns []string) string {
+	return fmt.Sprintf("%s/tablerows/%d/%s", connectionId, objectId, strings.Join(keyColumns, ","))
+}
+
+func ParseTableRowsId(ctx context.Context, id string) (tableRows TableRows) {
+	s := strings.Split(id, "/tablerows/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /tablerows/ exactly once")
+		return
+	}
+
+	tableRows.Connection = s[0]
+
+	parts := strings.SplitN(s[1], "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		logging.AddError(ctx, "Invalid id", "Unable to parse table rows id, expected <database>/tablerows/<object_id>/<key columns>")
+		return
+	}
+
+	objectId, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse table rows id")
+		return
+	}
+
+	tableRows.ObjectId = objectId
+	tableRows.Table = tableFormatId(tableRows.Connection, objectId)
+	tableRows.KeyColumns = strings.Split(parts[1], ",")
+	tableRows.Id = id
+
+	return
+}
+
+// Validate that the table and key columns exist and return the managed set of rows.
+func GetTableRows(ctx context.Context, connection Connection, tableId string, keyColumns []string) (tableRows TableRows) {
+	if len(keyColumns) == 0 {
+		logging.AddError(ctx, "Invalid config", "At least one key column is required")
+		return
+	}
+
+	if !strings.HasPrefix(tableId, connection.ConnectionId+"/table/") {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", tableId, connection.ConnectionId))
+		return
+	}
+
+	table := GetTableFromId(ctx, connection, tableId, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	columns := getTableColumns(ctx, connection, table.ObjectId)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	for _, key := range keyColumns {
+		if !columns[key] {
+			logging.AddError(ctx, "Column not found", fmt.Sprintf("Key column %s doesn't exist in table %s.%s", key, table.SchemaName, table.Name))
+			return
+		}
+	}
+
+	return TableRows{
+		Id:         tableRowsFormatId(connection.ConnectionId, table.ObjectId, keyColumns),
+		Connection: connection.ConnectionId,
+		Table:      table.Id,
+		ObjectId:   table.ObjectId,
+		KeyColumns: keyColumns,
+	}
+}
+
+func GetTableRowsFromId(ctx context.Context, connection Connection, id string) (tableRows TableRows) {
+	tableRows = ParseTableRowsId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if tableRows.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	return GetTableRows(ctx, connection, tableRows.Table, tableRows.KeyColumns)
+}
+
+func getTableColumns(ctx context.Context, connection Connection, objectId int64) (columns map[string]bool) {
+	columns = map[string]bool{}
+
+	rows, err := connection.Connection.QueryContext(ctx, "select name from sys.columns where object_id = @object_id", sql.Named("object_id", objectId))
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading columns of table %d failed", objectId), err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Reading columns of table %d failed", objectId), err)
+			return
+		}
+		columns[name] = true
+	}
+
+	return
+}
+
+// Return the columns of the rows, key columns first. All rows have to
+// specify the same columns and a non null value for every key column.
+func rowColumns(ctx context.Context, keyColumns []string, rows []TableRow) (columns []string) {
+	columns = append(columns, keyColumns...)
+
+	if len(rows) == 0 {
+		return
+	}
+
+	for name := range rows[0] {
+		if !containsString(keyColumns, name) {
+			columns = append(columns, name)
+		}
+	}
+	sort.Strings(columns[len(keyColumns):])
+
+	keys := map[string]bool{}
+
+	for index, row := range rows {
+		if len(row) != len(columns) {
+			logging.AddError(ctx, "Invalid row", fmt.Sprintf("Row %d doesn't specify the same columns as the first row (%s)", index, strings.Join(columns, ", ")))
+			return nil
+		}
+
+		var key []string
+		for _, column := range columns {
+			value, ok := row[column]
+			if !ok {
+				logging.AddError(ctx, "Invalid row", fmt.Sprintf("Row %d doesn't specify column %s", index, column))
+				return nil
+			}
+			if containsString(keyColumns, column) {
+				if !value.Valid {
+					logging.AddError(ctx, "Invalid row", fmt.Sprintf("Key column %s of row %d can't be null", column, index))
+					return nil
+				}
+				key = append(key, value.String)
+			}
+		}
+
+		if keys[strings.Join(key, "\x00")] {
+			logging.AddError(ctx, "Invalid row", fmt.Sprintf("Row %d has the same key as a previous row", index))
+			return nil
+		}
+		keys[strings.Join(key, "\x00")] = true
+	}
+
+	return columns
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func tableName(ctx context.Context, connection Connection, tableRows TableRows) string {
+	table := GetTableFromId(ctx, connection, tableRows.Table, true)
+	if logging.HasError(ctx) {
+		return ""
+	}
+	return fmt.Sprintf("%s.%s", quoteIdentifier(table.SchemaName), quoteIdentifier(table.Name))
+}
+
+// Rows are passed as a single json parameter and expanded with openjson, which avoids
+// the limit of 2100 parameters per statement. All values are passed as strings and
+// converted implicitly to the column types, like the literals in a SQL script.
+func rowsParameter(ctx context.Context, columns []string, rows []TableRow) any {
+	values := []map[string]any{}
+	for _, row := range rows {
+		value := map[string]any{}
+		for _, column := range columns {
+			if row[column].Valid {
+				value[column] = row[column].String
+			} else {
+				value[column] = nil
+			}
+		}
+		values = append(values, value)
+	}
+
+	rowsJson, err := json.Marshal(values)
+	if err != nil {
+		logging.AddError(ctx, "Serializing rows failed", err)
+		return nil
+	}
+
+	return sql.Named("rows", string(rowsJson))
+}
+
+// Schema of the openjson with clause, reading every column as a string.
+func openJsonColumns(columns []string) string {
+	var definitions []string
+	for _, column := range columns {
+		path := `$."` + strings.ReplaceAll(strings.ReplaceAll(column, `\`, `\\`), `"`, `\"`) + `"`
+		definitions = append(definitions, fmt.Sprintf("%s nvarchar(max) %s", quoteIdentifier(column), quoteString(path)))
+	}
+	return strings.Join(definitions, ", ")
+}
+
+// Condition joining the key columns of two aliases.
+func keyJoin(left string, right string, keyColumns []string) string {
+	var conditions []string
+	for _, column := range keyColumns {
+		conditions = append(conditions, fmt.Sprintf("%[1]s.%[3]s = %[2]s.%[3]s", left, right, quoteIdentifier(column)))
+	}
+	return strings.Join(conditions, " and ")
+}
+
+func buildMergeTableRowsQuery(table string, keyColumns []string, columns []string, deleteUnmanaged bool) string {
+	var quoted, sourceColumns, targetColumns, updates []string
+
+	for _, column := range columns {
+		quoted = append(quoted, quoteIdentifier(column))
+	}
+
+	for _, column := range quoted[len(keyColumns):] {
+		sourceColumns = append(sourceColumns, "source."+column)
+		targetColumns = append(targetColumns, "target."+column)
+		updates = append(updates, fmt.Sprintf("%[1]s = source.%[1]s", column))
+	}
+
+	query := fmt.Sprintf("merge into %s as target using (select * from openjson(@rows) with (%s)) as source on %s",
+		table, openJsonColumns(columns), keyJoin("target", "source", keyColumns))
+
+	if len(updates) > 0 {
+		// except treats nulls as equal, which avoids needless updates
+		query += fmt.Sprintf(" when matched and exists (select %s except select %s) then update set %s",
+			strings.Join(sourceColumns, ", "), strings.Join(targetColumns, ", "), strings.Join(updates, ", "))
+	}
+
+	query += fmt.Sprintf(" when not matched by target then insert (%s) values (source.%s)",
+		strings.Join(quoted, ", "), strings.Join(quoted, ", source."))
+
+	if deleteUnmanaged {
+		query += " when not matched by source then delete"
+	}
+
+	return query + ";"
+}
+
+// Insert or update the rows using a single MERGE statement. When deleteUnmanaged is set,
+// all rows of the table which aren't part of rows are deleted.
+func MergeTableRows(ctx context.Context, connection Connection, tableRows TableRows, rows []TableRow, deleteUnmanaged bool) {
+	columns := rowColumns(ctx, tableRows.KeyColumns, rows)
+	table := tableName(ctx, connection, tableRows)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if len(rows) == 0 {
+		if deleteUnmanaged {
+			_, err := connection.Connection.ExecContext(ctx, fmt.Sprintf("delete from %s", table))
+			logging.AddError(ctx, fmt.Sprintf("Deleting rows from %s failed", table), err)
+		}
+		return
+	}
+
+	param := rowsParameter(ctx, columns, rows)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := buildMergeTableRowsQuery(table, tableRows.KeyColumns, columns, deleteUnmanaged)
+	if _, err := connection.Connection.ExecContext(ctx, query, param); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Merging rows into %s failed", table), err)
+	}
+}
+
+// Delete the rows with the same key as the given rows.
+func DeleteTableRows(ctx context.Context, connection Connection, tableRows TableRows, rows []TableRow) {
+	if len(rows) == 0 {
+		return
+	}
+
+	table := tableName(ctx, connection, tableRows)
+	param := rowsParameter(ctx, tableRows.KeyColumns, rows)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := fmt.Sprintf("delete target from %s as target where exists (select 1 from openjson(@rows) with (%s) as source where %s)",
+		table, openJsonColumns(tableRows.KeyColumns), keyJoin("target", "source", tableRows.KeyColumns))
+
+	if _, err := connection.Connection.ExecContext(ctx, query, param); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Deleting rows from %s failed", table), err)
+	}
+}
+
+// The index of the configured row is returned first, followed by the values in the database
+// and whether they match the configured values.
+func buildReadTableRowsQuery(table string, keyColumns []string, columns []string) string {
+	var selected, sourceColumns, targetColumns []string
+	for _, column := range columns {
+		selected = append(selected, fmt.Sprintf("convert(nvarchar(max), target.%s)", quoteIdentifier(column)))
+		sourceColumns = append(sourceColumns, "source."+quoteIdentifier(column))
+		targetColumns = append(targetColumns, "target."+quoteIdentifier(column))
+	}
+
+	return fmt.Sprintf("select convert(int, items.[key]), %s, case when exists (select %s intersect select %s) then 1 else 0 end "+
+		"from openjson(@rows) as items cross apply openjson(items.value) with (%s) as source inner join %s as target on %s",
+		strings.Join(selected, ", "), strings.Join(targetColumns, ", "), strings.Join(sourceColumns, ", "),
+		openJsonColumns(columns), table, keyJoin("target", "source", keyColumns))
+}
+
+// Read the managed rows back from the table. A row which still matches the configured
+// values (using the comparison semantics of the database) is returned unchanged,
+// a row which differs is returned with the values in the database and a missing row is omitted.
+// When includeUnmanaged is set, all other rows in the table are appended.
+func ReadTableRows(ctx context.Context, connection Connection, tableRows TableRows, rows []TableRow, includeUnmanaged bool) (result []TableRow) {
+	columns := rowColumns(ctx, tableRows.KeyColumns, rows)
+	table := tableName(ctx, connection, tableRows)
+	param := rowsParameter(ctx, columns, rows)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	result = []TableRow{}
+
+	if len(rows) > 0 {
+		found := make([]TableRow, len(rows))
+
+		dbRows, err := connection.Connection.QueryContext(ctx, buildReadTableRowsQuery(table, tableRows.KeyColumns, columns), param)
+		if err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Reading rows from %s failed", table), err)
+			return
+		}
+		defer dbRows.Close()
+
+		for dbRows.Next() {
+			var index int
+			var matches bool
+			values := make([]sql.NullString, len(columns))
+			dest := []any{&index}
+			for i := range values {
+				dest = append(dest, &values[i])
+			}
+			dest = append(dest, &matches)
+
+			if err := dbRows.Scan(dest...); err != nil {
+				logging.AddError(ctx, fmt.Sprintf("Reading rows from %s failed", table), err)
+				return
+			}
+
+			if matches {
+				found[index] = rows[index]
+				continue
+			}
+
+			actual := TableRow{}
+			for i, column := range columns {
+				actual[column] = values[i]
+			}
+			found[index] = actual
+		}
+
+		// keep the configured order, omitting missing rows
+		for _, row := range found {
+			if row != nil {
+				result = append(result, row)
+			}
+		}
+	}
+
+	if includeUnmanaged {
+		result = append(result, readUnmanagedRows(ctx, connection, table, tableRows.KeyColumns, columns, rows)...)
+	}
+
+	return result
+}
+
+func readUnmanagedRows(ctx context.Context, connection Connection, table string, keyColumns []string, columns []string, managed []TableRow) (result []TableRow) {
+	var selected []string
+	for _, column := range columns {
+		selected = append(selected, fmt.Sprintf("convert(nvarchar(max), target.%s)", quoteIdentifier(column)))
+	}
+
+	param := rowsParameter(ctx, keyColumns, managed)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := fmt.Sprintf("select %s from %s as target where not exists (select 1 from openjson(@rows) with (%s) as source where %s)",
+		strings.Join(selected, ", "), table, openJsonColumns(keyColumns), keyJoin("target", "source", keyColumns))
+
+	rows, err := connection.Connection.QueryContext(ctx, query, param)
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading rows from %s failed", table), err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		dest := []any{}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Reading rows from %s failed", table), err)
+			return
+		}
+
+		row := TableRow{}
+		for i, column := range columns {
+			row[column] = values[i]
+		}
+		result = append(result, row)
+	}
+
+	return result
+}
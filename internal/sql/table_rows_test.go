@@ -0,0 +1,107 @@
+package sql
+
+import (
+	"database/sql"
+	"terraform-provider-azuresql/internal/logging"
+	"testing"
+)
+
+func TestParseTableRowsId(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	tableRows := ParseTableRowsId(ctx, "sqlserver::server:1433:db/tablerows/1234/code,language")
+	if logging.HasError(ctx) {
+		t.Fatalf("Unexpected error")
+	}
+
+	if tableRows.Table != "sqlserver::server:1433:db/table/1234" {
+		t.Errorf("Unexpected table id %s", tableRows.Table)
+	}
+
+	if len(tableRows.KeyColumns) != 2 || tableRows.KeyColumns[0] != "code" || tableRows.KeyColumns[1] != "language" {
+		t.Errorf("Unexpected key columns %v", tableRows.KeyColumns)
+	}
+}
+
+func TestRowColumns(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	rows := []TableRow{
+		{"name": NewNullString("Belgium"), "code": NewNullString("BE"), "active": NewNullString("1")},
+		{"name": sql.NullString{}, "code": NewNullString("FR"), "active": NewNullString("0")},
+	}
+
+	columns := rowColumns(ctx, []string{"code"}, rows)
+	if logging.HasError(ctx) {
+		t.Fatalf("Unexpected error")
+	}
+
+	if len(columns) != 3 || columns[0] != "code" || columns[1] != "active" || columns[2] != "name" {
+		t.Errorf("Expected key column first followed by sorted columns, got %v", columns)
+	}
+}
+
+func TestRowColumnsDuplicateKey(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	rows := []TableRow{
+		{"code": NewNullString("BE"), "name": NewNullString("Belgium")},
+		{"code": NewNullString("BE"), "name": NewNullString("België")},
+	}
+
+	rowColumns(ctx, []string{"code"}, rows)
+	if !logging.HasError(ctx) {
+		t.Errorf("Rows with the same key should raise an error")
+	}
+}
+
+func TestRowColumnsMissingColumn(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	rows := []TableRow{
+		{"code": NewNullString("BE"), "name": NewNullString("Belgium")},
+		{"code": NewNullString("FR"), "active": NewNullString("1")},
+	}
+
+	rowColumns(ctx, []string{"code"}, rows)
+	if !logging.HasError(ctx) {
+		t.Errorf("Rows with different columns should raise an error")
+	}
+}
+
+func TestBuildMergeTableRowsQuery(t *testing.T) {
+	query := buildMergeTableRowsQuery("[dbo].[countries]", []string{"code"}, []string{"code", "name"}, true)
+	expected := `merge into [dbo].[countries] as target using (select * from openjson(@rows) with ([code] nvarchar(max) '$."code"', [name] nvarchar(max) '$."name"')) as source on target.[code] = source.[code]` +
+		` when matched and exists (select source.[name] except select target.[name]) then update set [name] = source.[name]` +
+		` when not matched by target then insert ([code], [name]) values (source.[code], source.[name])` +
+		` when not matched by source then delete;`
+
+	if query != expected {
+		t.Errorf("Unexpected query %s", query)
+	}
+}
+
+func TestBuildReadTableRowsQuery(t *testing.T) {
+	query := buildReadTableRowsQuery("[dbo].[countries]", []string{"code"}, []string{"code", `my"name`})
+	expected := `select convert(int, items.[key]), convert(nvarchar(max), target.[code]), convert(nvarchar(max), target.[my"name]),` +
+		` case when exists (select target.[code], target.[my"name] intersect select source.[code], source.[my"name]) then 1 else 0 end` +
+		` from openjson(@rows) as items cross apply openjson(items.value) with ([code] nvarchar(max) '$."code"', [my"name] nvarchar(max) '$."my\"name"') as source` +
+		` inner join [dbo].[countries] as target on target.[code] = source.[code]`
+
+	if query != expected {
+		t.Errorf("Unexpected query %s", query)
+	}
+}
+
+func TestRowsParameter(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	rows := []TableRow{
+		{"code": NewNullString("BE"), "name": sql.NullString{}},
+	}
+
+	param := rowsParameter(ctx, []string{"code", "name"}, rows).(sql.NamedArg)
+	if param.Value != `[{"code":"BE","name":null}]` {
+		t.Errorf("Unexpected rows parameter %s", param.Value)
+	}
+}
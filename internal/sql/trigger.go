@@ -0,0 +1,297 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+// DML trigger on a table, or DDL trigger on the database when Table is empty.
+type Trigger struct {
+	Id         string
+	Connection string
+	Name       string
+	ObjectId   int64
+	Table      string
+	Type       string
+	Events     []string
+	Definition string
+	Enabled    bool
+}
+
+func triggerFormatId(connectionId string, objectId int64) string {
+	return fmt.Sprintf("%s/trigger/%d", connectionId, objectId)
+}
+
+func ParseTriggerId(ctx context.Context, id string) (trigger Trigger) {
+	s := strings.Split(id, "/trigger/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /trigger/ exactly once")
+		return
+	}
+
+	trigger.Connection = s[0]
+
+	objectId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse trigger id")
+		return
+	}
+
+	trigger.ObjectId = objectId
+
+	return
+}
+
+// Quoted name of the trigger and the target of its ON clause.
+// DML triggers live in the schema of their table, DDL triggers don't have a schema.
+func triggerTarget(ctx context.Context, connection Connection, name string, tableResourceId string) (quotedName string, target string) {
+	if tableResourceId == "" {
+		return quoteIdentifier(name), "database"
+	}
+
+	table := GetTableFromId(ctx, connection, tableResourceId, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	return fmt.Sprintf("%s.%s", quoteIdentifier(table.SchemaName), quoteIdentifier(name)),
+		fmt.Sprintf("%s.%s", quoteIdentifier(table.SchemaName), quoteIdentifier(table.Name))
+}
+
+func buildTriggerQuery(verb string, quotedName string, target string, trigger Trigger) string {
+	return fmt.Sprintf("%s trigger %s on %s %s %s as\n%s",
+		verb, quotedName, target, trigger.Type, strings.Join(trigger.Events, ", "), trigger.Definition)
+}
+
+func setTriggerEnabled(ctx context.Context, connection Connection, quotedName string, target string, enabled bool) {
+	verb := "disable"
+	if enabled {
+		verb = "enable"
+	}
+
+	query := fmt.Sprintf("%s trigger %s on %s", verb, quotedName, target)
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Changing state of trigger %s failed", quotedName), err)
+	}
+}
+
+func CreateTrigger(ctx context.Context, connection Connection, name string, tableResourceId string, trigger Trigger) Trigger {
+
+	quotedName, target := triggerTarget(ctx, connection, name, tableResourceId)
+	if logging.HasError(ctx) {
+		return Trigger{}
+	}
+
+	_, err := connection.Connection.ExecContext(ctx, buildTriggerQuery("create", quotedName, target, trigger))
+
+	if err != nil {
+		logging.AddError(ctx, "Trigger creation failed", err)
+		return Trigger{}
+	}
+
+	if !trigger.Enabled {
+		setTriggerEnabled(ctx, connection, quotedName, target, false)
+	}
+
+	trigger = GetTriggerFromName(ctx, connection, name, tableResourceId, false)
+	if !logging.HasError(ctx) && trigger.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created trigger", fmt.Sprintf("Unable to read trigger %s after creation.", name))
+	}
+
+	return trigger
+}
+
+var triggerStatement = regexp.MustCompile(`(?is)^\s*(?:create\s+or\s+alter|create|alter)\s+trigger\s+.+?\s+on\s+.+?\s+(?:with\s+.+?\s+)?(for|after|instead\s+of)\s+(.+?)\s+(?:with\s+append\s+)?(?:not\s+for\s+replication\s+)?as\s+(.*)$`)
+var triggerWhitespace = regexp.MustCompile(`\s+`)
+
+// Split the statement stored in sys.sql_modules into the type, events and definition of the trigger.
+func parseTriggerStatement(ctx context.Context, statement string) (triggerType string, events []string, definition string) {
+	match := triggerStatement.FindStringSubmatch(statement)
+	if match == nil {
+		logging.AddError(ctx, "Failed to parse trigger definition", fmt.Sprintf("Couldn't find the events and ` as ` indicating the start of the definition %s", statement))
+		return
+	}
+
+	// for is a synonym of after
+	triggerType = strings.ToLower(triggerWhitespace.ReplaceAllString(match[1], " "))
+	if triggerType == "for" {
+		triggerType = "after"
+	}
+
+	for _, event := range strings.Split(match[2], ",") {
+		events = append(events, strings.TrimSpace(event))
+	}
+
+	return triggerType, events, cleanTriggerDefinition(match[3])
+}
+
+// Remove line ending differences and a trailing statement terminator, which SQL Server keeps as written.
+func cleanTriggerDefinition(definition string) string {
+	retval := strings.ReplaceAll(definition, "\r\n", "\n")
+	retval = strings.TrimSpace(retval)
+	retval = strings.TrimSpace(strings.TrimRight(retval, ";"))
+	return retval
+}
+
+// Compare two trigger definitions, ignoring differences in whitespace and a trailing statement terminator.
+func IsTriggerDefinitionEquivalent(definition1 string, definition2 string) bool {
+	return triggerWhitespace.ReplaceAllString(cleanTriggerDefinition(definition1), " ") ==
+		triggerWhitespace.ReplaceAllString(cleanTriggerDefinition(definition2), " ")
+}
+
+// Compare two lists of trigger events, ignoring case and order.
+func IsTriggerEventsEquivalent(events1 []string, events2 []string) bool {
+	if len(events1) != len(events2) {
+		return false
+	}
+
+	for _, event := range events1 {
+		found := false
+		for _, other := range events2 {
+			if strings.EqualFold(event, other) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func GetTriggerFromName(ctx context.Context, connection Connection, name string, tableResourceId string, requiresExist bool) (trigger Trigger) {
+	// database triggers have parent_id 0
+	var parentId int64
+	if tableResourceId != "" {
+		table := parseTableId(ctx, tableResourceId)
+		if logging.HasError(ctx) {
+			return
+		}
+		parentId = table.ObjectId
+	}
+
+	var objectId int64
+	query := "select object_id from sys.triggers where name = @name and parent_id = @parent_id"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name), sql.Named("parent_id", parentId)).Scan(&objectId)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Trigger not found", fmt.Sprintf("Trigger with name %s doesn't exist", name))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading trigger %s failed", name), err)
+		return
+	}
+
+	return GetTriggerFromObjectId(ctx, connection, objectId, requiresExist)
+}
+
+func GetTriggerFromObjectId(ctx context.Context, connection Connection, objectId int64, requiresExist bool) (trigger Trigger) {
+	var parentClass, parentId int64
+	var disabled bool
+	var statement string
+
+	query := `
+		select tr.name, tr.parent_class, tr.parent_id, tr.is_disabled, mod.definition
+		from sys.triggers tr
+		inner join sys.sql_modules mod
+		on tr.object_id = mod.object_id
+		where tr.object_id = @object_id`
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("object_id", objectId)).Scan(
+		&trigger.Name, &parentClass, &parentId, &disabled, &statement)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Trigger not found", fmt.Sprintf("Trigger with objectId %d doesn't exist", objectId))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading trigger %d failed", objectId), err)
+		return
+	}
+
+	trigger.Id = triggerFormatId(connection.ConnectionId, objectId)
+	trigger.Connection = connection.ConnectionId
+	trigger.ObjectId = objectId
+	trigger.Enabled = !disabled
+
+	// parent_class 1 is an object (table), 0 the database
+	if parentClass == 1 {
+		trigger.Table = tableFormatId(connection.ConnectionId, parentId)
+	}
+
+	trigger.Type, trigger.Events, trigger.Definition = parseTriggerStatement(ctx, statement)
+
+	return
+}
+
+func GetTriggerFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (trigger Trigger) {
+	trigger = ParseTriggerId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if trigger.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	return GetTriggerFromObjectId(ctx, connection, trigger.ObjectId, requiresExist)
+}
+
+// Change a trigger in place. When alter is set, the type, events and definition
+// are replaced using ALTER TRIGGER, afterwards the trigger is enabled or disabled.
+func UpdateTrigger(ctx context.Context, connection Connection, id string, trigger Trigger, alter bool) {
+	current := GetTriggerFromId(ctx, connection, id, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	quotedName, target := triggerTarget(ctx, connection, current.Name, current.Table)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if alter {
+		if _, err := connection.Connection.ExecContext(ctx, buildTriggerQuery("alter", quotedName, target, trigger)); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Updating trigger %s failed", quotedName), err)
+			return
+		}
+	}
+
+	setTriggerEnabled(ctx, connection, quotedName, target, trigger.Enabled)
+}
+
+func DropTrigger(ctx context.Context, connection Connection, id string) {
+
+	trigger := GetTriggerFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || trigger.Id == "" {
+		return
+	}
+
+	quotedName, _ := triggerTarget(ctx, connection, trigger.Name, trigger.Table)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := fmt.Sprintf("drop trigger %s", quotedName)
+	if trigger.Table == "" {
+		query += " on database"
+	}
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping trigger %s failed", quotedName), err)
+	}
+}
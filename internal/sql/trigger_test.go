@@ -0,0 +1,91 @@
+package sql
+
+import (
+	"terraform-provider-azuresql/internal/logging"
+	"testing"
+)
+
+func TestParseTriggerStatement(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	trigger := Trigger{Type: "instead of", Events: []string{"insert", "update"}, Definition: "select 1 as x"}
+	statement := buildTriggerQuery("create", "[dbo].[trigger]", "[dbo].[table]", trigger)
+
+	triggerType, events, definition := parseTriggerStatement(ctx, statement)
+	if logging.HasError(ctx) {
+		t.Fatalf("Parsing trigger statement failed")
+	}
+
+	if triggerType != "instead of" {
+		t.Errorf("Unexpected type %s", triggerType)
+	}
+	if !IsTriggerEventsEquivalent(events, trigger.Events) {
+		t.Errorf("Unexpected events %v", events)
+	}
+	if definition != trigger.Definition {
+		t.Errorf("Unexpected definition %s", definition)
+	}
+}
+
+func TestParseDatabaseTriggerStatement(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	statement := `CREATE TRIGGER audit ON DATABASE
+		WITH EXECUTE AS OWNER
+		FOR DDL_DATABASE_LEVEL_EVENTS AS
+		insert into dbo.log values (eventdata())`
+
+	triggerType, events, definition := parseTriggerStatement(ctx, statement)
+	if logging.HasError(ctx) {
+		t.Fatalf("Parsing trigger statement failed")
+	}
+
+	if triggerType != "after" {
+		t.Errorf("for should be parsed as after, got %s", triggerType)
+	}
+	if len(events) != 1 || events[0] != "DDL_DATABASE_LEVEL_EVENTS" {
+		t.Errorf("Unexpected events %v", events)
+	}
+	if definition != "insert into dbo.log values (eventdata())" {
+		t.Errorf("Unexpected definition %s", definition)
+	}
+}
+
+func TestIsTriggerEventsEquivalent(t *testing.T) {
+	if !IsTriggerEventsEquivalent([]string{"insert", "UPDATE"}, []string{"update", "INSERT"}) {
+		t.Errorf("Events should be equivalent ignoring case and order")
+	}
+	if IsTriggerEventsEquivalent([]string{"insert"}, []string{"insert", "delete"}) {
+		t.Errorf("Different events should not be equivalent")
+	}
+}
+
+func TestParseCreateOrAlterTriggerStatement(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	statement := "CREATE OR ALTER TRIGGER [dbo].[audit] ON [dbo].[orders]\r\nAFTER INSERT, DELETE AS\r\nselect 1 as x;\r\n"
+
+	triggerType, events, definition := parseTriggerStatement(ctx, statement)
+	if logging.HasError(ctx) {
+		t.Fatalf("Parsing trigger statement failed")
+	}
+
+	if triggerType != "after" {
+		t.Errorf("Unexpected type %s", triggerType)
+	}
+	if !IsTriggerEventsEquivalent(events, []string{"insert", "delete"}) {
+		t.Errorf("Unexpected events %v", events)
+	}
+	if definition != "select 1 as x" {
+		t.Errorf("Unexpected definition %s", definition)
+	}
+}
+
+func TestIsTriggerDefinitionEquivalent(t *testing.T) {
+	if !IsTriggerDefinitionEquivalent("insert into dbo.log\r\n  select * from inserted;", "insert into dbo.log\n\tselect * from inserted") {
+		t.Errorf("Definitions should be equivalent ignoring whitespace and a trailing semicolon")
+	}
+	if IsTriggerDefinitionEquivalent("select 1", "select 2") {
+		t.Errorf("Different definitions should not be equivalent")
+	}
+}
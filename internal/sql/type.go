@@ -0,0 +1,378 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-azuresql/internal/logging"
+)
+
+type TypeColumn struct {
+	Name     string
+	Type     string
+	Nullable bool
+}
+
+type TypeIndex struct {
+	Name    string
+	Columns []string
+	Unique  bool
+}
+
+// User-defined alias type or table type.
+// BaseType and Nullable are only used by alias types, Columns, PrimaryKey and Indexes by table types.
+type Type struct {
+	Id          string
+	Connection  string
+	Name        string
+	Schema      string
+	TypeId      int64
+	IsTableType bool
+	BaseType    string
+	Nullable    bool
+	Columns     []TypeColumn
+	PrimaryKey  []string
+	Indexes     []TypeIndex
+}
+
+func typeFormatId(connectionId string, typeId int64) string {
+	return fmt.Sprintf("%s/type/%d", connectionId, typeId)
+}
+
+func isTypeId(id string) bool {
+	return strings.Contains(id, "/type/")
+}
+
+func ParseTypeId(ctx context.Context, id string) (userType Type) {
+	s := strings.Split(id, "/type/")
+
+	if len(s) != 2 {
+		logging.AddError(ctx, "ID format error", "id doesn't contain /type/ exactly once")
+		return
+	}
+
+	userType.Connection = s[0]
+
+	typeId, err := strconv.ParseInt(s[1], 10, 64)
+	if err != nil {
+		logging.AddError(ctx, "Invalid id", "Unable to parse type id")
+		return
+	}
+
+	userType.TypeId = typeId
+
+	return
+}
+
+func quoteColumns(columns []string) string {
+	var quoted []string
+	for _, column := range columns {
+		quoted = append(quoted, quoteIdentifier(column))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func buildTypeQuery(name string, schemaName string, userType Type) string {
+	if !userType.IsTableType {
+		nullable := "not null"
+		if userType.Nullable {
+			nullable = "null"
+		}
+		return fmt.Sprintf("create type %s.%s from %s %s", quoteIdentifier(schemaName), quoteIdentifier(name), userType.BaseType, nullable)
+	}
+
+	var definitions []string
+	for _, column := range userType.Columns {
+		nullable := "not null"
+		// primary key columns can't be nullable
+		if column.Nullable && !containsString(userType.PrimaryKey, column.Name) {
+			nullable = "null"
+		}
+		definitions = append(definitions, fmt.Sprintf("%s %s %s", quoteIdentifier(column.Name), column.Type, nullable))
+	}
+
+	if len(userType.PrimaryKey) > 0 {
+		definitions = append(definitions, fmt.Sprintf("primary key (%s)", quoteColumns(userType.PrimaryKey)))
+	}
+
+	for _, index := range userType.Indexes {
+		// table types only support unique indexes as unique constraints, which are named by the system;
+		// a name for a unique index is rejected during validation
+		if index.Unique {
+			definitions = append(definitions, fmt.Sprintf("unique (%s)", quoteColumns(index.Columns)))
+		} else {
+			definitions = append(definitions, fmt.Sprintf("index %s (%s)", quoteIdentifier(index.Name), quoteColumns(index.Columns)))
+		}
+	}
+
+	return fmt.Sprintf("create type %s.%s as table (\n\t%s\n)", quoteIdentifier(schemaName), quoteIdentifier(name), strings.Join(definitions, ",\n\t"))
+}
+
+func CreateType(ctx context.Context, connection Connection, name string, schemaResourceId string, userType Type) Type {
+
+	schema := GetSchemaFromId(ctx, connection, schemaResourceId, true)
+
+	if logging.HasError(ctx) {
+		return Type{}
+	}
+
+	query := buildTypeQuery(name, schema.Name, userType)
+
+	_, err := connection.Connection.ExecContext(ctx, query)
+
+	if err != nil {
+		logging.AddError(ctx, "Type creation failed", err)
+		return Type{}
+	}
+
+	userType = GetTypeFromNameAndSchema(ctx, connection, name, schemaResourceId, false)
+	if !logging.HasError(ctx) && userType.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created type", fmt.Sprintf("Unable to read type %s after creation.", name))
+	}
+
+	return userType
+}
+
+// Format a type as it is written in a definition, e.g. nvarchar(50) or decimal(10,2).
+func formatType(typeName string, maxLength int64, precision int64, scale int64) string {
+	switch strings.ToLower(typeName) {
+	case "varchar", "char", "varbinary", "binary":
+		if maxLength == -1 {
+			return typeName + "(max)"
+		}
+		return fmt.Sprintf("%s(%d)", typeName, maxLength)
+	case "nvarchar", "nchar":
+		if maxLength == -1 {
+			return typeName + "(max)"
+		}
+		return fmt.Sprintf("%s(%d)", typeName, maxLength/2)
+	case "decimal", "numeric":
+		return fmt.Sprintf("%s(%d,%d)", typeName, precision, scale)
+	case "datetime2", "time", "datetimeoffset":
+		return fmt.Sprintf("%s(%d)", typeName, scale)
+	default:
+		return typeName
+	}
+}
+
+// Default length, precision or scale of the types which support one.
+var typeDefaults = map[string]string{
+	"varchar":        "(1)",
+	"char":           "(1)",
+	"varbinary":      "(1)",
+	"binary":         "(1)",
+	"nvarchar":       "(1)",
+	"nchar":          "(1)",
+	"decimal":        "(18,0)",
+	"numeric":        "(18,0)",
+	"datetime2":      "(7)",
+	"time":           "(7)",
+	"datetimeoffset": "(7)",
+}
+
+var typeWhitespace = regexp.MustCompile(`[\s\[\]]`)
+
+func normalizeType(definition string) string {
+	normalized := strings.ToLower(typeWhitespace.ReplaceAllString(definition, ""))
+	if suffix, ok := typeDefaults[normalized]; ok {
+		normalized += suffix
+	}
+	return strings.TrimPrefix(normalized, "sys.")
+}
+
+// Compare two type definitions, ignoring case, quotes and default lengths.
+func IsTypeDefinitionEquivalent(definition1 string, definition2 string) bool {
+	return normalizeType(definition1) == normalizeType(definition2)
+}
+
+func GetTypeFromNameAndSchema(ctx context.Context, connection Connection, name string, schemaResourceId string, requiresExist bool) (userType Type) {
+	schema := ParseSchemaId(ctx, schemaResourceId)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	var typeId int64
+	query := "select user_type_id from sys.types where is_user_defined = 1 and name = @name and schema_id = @schema_id"
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("name", name), sql.Named("schema_id", schema.SchemaId)).Scan(&typeId)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Type not found", fmt.Sprintf("Type with name %s doesn't exist", name))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading type %s failed", name), err)
+		return
+	}
+
+	return GetTypeFromTypeId(ctx, connection, typeId, requiresExist)
+}
+
+func GetTypeFromTypeId(ctx context.Context, connection Connection, typeId int64, requiresExist bool) (userType Type) {
+	var schemaId, maxLength, precision, scale int64
+	var baseType string
+
+	query := `
+		select name, schema_id, is_table_type, is_nullable, type_name(system_type_id), max_length, precision, scale
+		from sys.types
+		where is_user_defined = 1 and user_type_id = @type_id`
+
+	err := connection.Connection.QueryRowContext(ctx, query, sql.Named("type_id", typeId)).Scan(
+		&userType.Name, &schemaId, &userType.IsTableType, &userType.Nullable, &baseType, &maxLength, &precision, &scale)
+	switch {
+	case err == sql.ErrNoRows:
+		if requiresExist {
+			logging.AddError(ctx, "Type not found", fmt.Sprintf("Type with id %d doesn't exist", typeId))
+		}
+		return
+	case err != nil:
+		logging.AddError(ctx, fmt.Sprintf("Reading type %d failed", typeId), err)
+		return
+	}
+
+	userType.Id = typeFormatId(connection.ConnectionId, typeId)
+	userType.Connection = connection.ConnectionId
+	userType.TypeId = typeId
+	userType.Schema = schemaFormatId(connection.ConnectionId, schemaId)
+
+	if userType.IsTableType {
+		var tableObjectId int64
+		query = "select type_table_object_id from sys.table_types where user_type_id = @type_id"
+		if err := connection.Connection.QueryRowContext(ctx, query, sql.Named("type_id", typeId)).Scan(&tableObjectId); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Reading type %d failed", typeId), err)
+			return
+		}
+		userType.Columns = getColumns(ctx, connection, tableObjectId)
+		if logging.HasError(ctx) {
+			return
+		}
+		userType.PrimaryKey, userType.Indexes = getTypeIndexes(ctx, connection, tableObjectId)
+	} else {
+		userType.BaseType = formatType(baseType, maxLength, precision, scale)
+	}
+
+	return
+}
+
+// Columns of a table, external table or table type, in order.
+func getColumns(ctx context.Context, connection Connection, objectId int64) (columns []TypeColumn) {
+	query := `
+		select col.name, types.is_user_defined, schema_name(types.schema_id), types.name,
+			col.max_length, col.precision, col.scale, col.is_nullable
+		from sys.columns col
+		inner join sys.types types on types.user_type_id = col.user_type_id
+		where col.object_id = @object_id
+		order by col.column_id`
+
+	rows, err := connection.Connection.QueryContext(ctx, query, sql.Named("object_id", objectId))
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading columns of object %d failed", objectId), err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var column TypeColumn
+		var userDefined bool
+		var schemaName, typeName string
+		var maxLength, precision, scale int64
+
+		if err := rows.Scan(&column.Name, &userDefined, &schemaName, &typeName, &maxLength, &precision, &scale, &column.Nullable); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Reading columns of object %d failed", objectId), err)
+			return
+		}
+
+		if userDefined {
+			column.Type = fmt.Sprintf("%s.%s", schemaName, typeName)
+		} else {
+			column.Type = formatType(typeName, maxLength, precision, scale)
+		}
+		columns = append(columns, column)
+	}
+
+	return
+}
+
+// Primary key and indexes of a table type, in order of creation.
+// Unique constraints are named by the system, so their name isn't returned.
+func getTypeIndexes(ctx context.Context, connection Connection, objectId int64) (primaryKey []string, indexes []TypeIndex) {
+	query := `
+		select i.index_id, i.name, isnull(kc.type, ''), i.is_unique, col.name
+		from sys.indexes i
+		inner join sys.index_columns ic on ic.object_id = i.object_id and ic.index_id = i.index_id and ic.is_included_column = 0
+		inner join sys.columns col on col.object_id = ic.object_id and col.column_id = ic.column_id
+		left join sys.key_constraints kc on kc.parent_object_id = i.object_id and kc.unique_index_id = i.index_id
+		where i.object_id = @object_id and i.type > 0
+		order by i.index_id, ic.key_ordinal`
+
+	rows, err := connection.Connection.QueryContext(ctx, query, sql.Named("object_id", objectId))
+	if err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Reading indexes of object %d failed", objectId), err)
+		return
+	}
+	defer rows.Close()
+
+	lastIndexId := int64(-1)
+	for rows.Next() {
+		var indexId int64
+		var indexName, constraintType, columnName string
+		var unique bool
+
+		if err := rows.Scan(&indexId, &indexName, &constraintType, &unique, &columnName); err != nil {
+			logging.AddError(ctx, fmt.Sprintf("Reading indexes of object %d failed", objectId), err)
+			return
+		}
+
+		switch strings.TrimSpace(constraintType) {
+		case "PK":
+			primaryKey = append(primaryKey, columnName)
+			continue
+		case "UQ":
+			indexName = ""
+		}
+
+		if indexId != lastIndexId {
+			indexes = append(indexes, TypeIndex{Name: indexName, Unique: unique})
+			lastIndexId = indexId
+		}
+		indexes[len(indexes)-1].Columns = append(indexes[len(indexes)-1].Columns, columnName)
+	}
+
+	return
+}
+
+func GetTypeFromId(ctx context.Context, connection Connection, id string, requiresExist bool) (userType Type) {
+	userType = ParseTypeId(ctx, id)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if userType.Connection != connection.ConnectionId {
+		logging.AddError(ctx, "Connection mismatch", fmt.Sprintf("Id %s doesn't belong to connection %s", id, connection.ConnectionId))
+		return
+	}
+
+	return GetTypeFromTypeId(ctx, connection, userType.TypeId, requiresExist)
+}
+
+func DropType(ctx context.Context, connection Connection, id string) {
+
+	userType := GetTypeFromId(ctx, connection, id, false)
+	if logging.HasError(ctx) || userType.Id == "" {
+		return
+	}
+	schema := GetSchemaFromId(ctx, connection, userType.Schema, true)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := fmt.Sprintf("drop type %s.%s", quoteIdentifier(schema.Name), quoteIdentifier(userType.Name))
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Dropping type %s.%s failed", schema.Name, userType.Name), err)
+	}
+}
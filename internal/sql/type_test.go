@@ -0,0 +1,78 @@
+package sql
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildAliasTypeQuery(t *testing.T) {
+	query := buildTypeQuery("email", "dbo", Type{BaseType: "nvarchar(320)", Nullable: false})
+
+	if query != "create type [dbo].[email] from nvarchar(320) not null" {
+		t.Errorf("Unexpected query %s", query)
+	}
+}
+
+func TestBuildTableTypeQuery(t *testing.T) {
+	userType := Type{
+		IsTableType: true,
+		Columns: []TypeColumn{
+			{Name: "id", Type: "int", Nullable: true},
+			{Name: "name", Type: "nvarchar(100)", Nullable: true},
+		},
+		PrimaryKey: []string{"id"},
+		Indexes: []TypeIndex{
+			{Name: "ix_name", Columns: []string{"name"}},
+			{Name: "uq_name", Columns: []string{"name", "id"}, Unique: true},
+		},
+	}
+
+	query := buildTypeQuery("lines", "dbo", userType)
+
+	for _, expected := range []string{
+		"create type [dbo].[lines] as table (",
+		"[id] int not null",
+		"[name] nvarchar(100) null",
+		"primary key ([id])",
+		"index [ix_name] ([name])",
+		"unique ([name], [id])",
+	} {
+		if !strings.Contains(query, expected) {
+			t.Errorf("Query doesn't contain %s: %s", expected, query)
+		}
+	}
+}
+
+func TestIsTypeDefinitionEquivalent(t *testing.T) {
+	equivalent := [][]string{
+		{"NVARCHAR(50)", "nvarchar(50)"},
+		{"decimal", "decimal(18,0)"},
+		{"decimal(10, 2)", "decimal(10,2)"},
+		{"varchar", "varchar(1)"},
+		{"[dbo].[email]", "dbo.email"},
+		{"datetime2", "datetime2(7)"},
+	}
+	for _, pair := range equivalent {
+		if !IsTypeDefinitionEquivalent(pair[0], pair[1]) {
+			t.Errorf("%s should be equivalent to %s", pair[0], pair[1])
+		}
+	}
+
+	if IsTypeDefinitionEquivalent("nvarchar(50)", "nvarchar(100)") {
+		t.Errorf("Types with different lengths should not be equivalent")
+	}
+}
+
+func TestFormatType(t *testing.T) {
+	cases := map[string]string{
+		formatType("nvarchar", 100, 0, 0): "nvarchar(50)",
+		formatType("varchar", -1, 0, 0):   "varchar(max)",
+		formatType("decimal", 9, 10, 2):   "decimal(10,2)",
+		formatType("int", 4, 10, 0):       "int",
+	}
+	for actual, expected := range cases {
+		if actual != expected {
+			t.Errorf("Expected %s, got %s", expected, actual)
+		}
+	}
+}
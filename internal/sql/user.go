@@ -64,6 +64,10 @@ func describeUserType(ctx context.Context, userType string) (userTypeLong string
 		return "AD group"
 	case "E":
 		return "AD user"
+	case "C":
+		return "Certificate user"
+	case "K":
+		return "Asymmetric key user"
 	default:
 		logging.AddWarning(ctx, "Unrecognized user type", fmt.Sprintf("Unrecognized user type %s", userType))
 		return "Unrecongized"
@@ -86,7 +90,41 @@ func describeAuthentication(ctx context.Context, authentication_type int64) (aut
 	}
 }
 
-func CreateUser(ctx context.Context, connection Connection, name string, password string, authentication string, loginId string, entraid_identifier string) (user User) {
+// Users mapped to a certificate or asymmetric key have authentication type NONE, like users without login
+func describeUserAuthentication(ctx context.Context, userType string, authentication_type int64) (authentication string) {
+	switch userType {
+	case "C":
+		return "Certificate"
+	case "K":
+		return "AsymmetricKey"
+	default:
+		return describeAuthentication(ctx, authentication_type)
+	}
+}
+
+// Groups are created with type X, users and applications with type E
+func entraIDUserType(entraidType string) string {
+	if entraidType == "group" {
+		return "X"
+	}
+	return "E"
+}
+
+// Parses the id of the login of a user and checks it belongs to the server of the user
+func parseUserLoginId(ctx context.Context, connection Connection, loginId string) (login Login) {
+	login = ParseLoginId(ctx, loginId)
+	login_connection := ParseConnectionId(ctx, login.Connection)
+
+	if login_connection.Provider != connection.Provider || login_connection.Server != connection.Server {
+		logging.AddError(ctx, "Login/user incompatible",
+			fmt.Sprintf("Login from %s is incompatible with a user from %s", login_connection.ConnectionId, connection.ConnectionId))
+	}
+	return
+}
+
+// keyId is the id of the certificate or asymmetric key for the `Certificate` and `AsymmetricKey` authentication
+// entraidType is one of user, group or application, the entraid_identifier of an application is its client id
+func CreateUser(ctx context.Context, connection Connection, name string, password string, authentication string, loginId string, entraid_identifier string, entraidType string, keyId string) (user User) {
 
 	query := fmt.Sprintf("create user [%s]", name)
 
@@ -98,15 +136,11 @@ func CreateUser(ctx context.Context, connection Connection, name string, passwor
 			if logging.HasError(ctx) {
 				return
 			}
-			query += " with sid=" + sid + ", type=E"
+			query += " with sid=" + sid + ", type=" + entraIDUserType(entraidType)
 		}
 	} else if authentication == "SQLLogin" {
-		login := ParseLoginId(ctx, loginId)
-		login_connection := ParseConnectionId(ctx, login.Connection)
-
-		if login_connection.Provider != connection.Provider || login_connection.Server != connection.Server {
-			logging.AddError(ctx, "Login/user incompatible",
-				fmt.Sprintf("Login from %s is incompatible with a user from %s", login_connection.ConnectionId, connection.ConnectionId))
+		login := parseUserLoginId(ctx, connection, loginId)
+		if logging.HasError(ctx) {
 			return
 		}
 
@@ -115,6 +149,18 @@ func CreateUser(ctx context.Context, connection Connection, name string, passwor
 		query += fmt.Sprintf(" with password='%s'", password)
 	} else if authentication == "WithoutLogin" {
 		query += " without login"
+	} else if authentication == "Certificate" {
+		certificate := GetCertificateFromId(ctx, connection, keyId, true)
+		if logging.HasError(ctx) {
+			return
+		}
+		query += fmt.Sprintf(" from certificate %s", quoteIdentifier(certificate.Name))
+	} else if authentication == "AsymmetricKey" {
+		asymmetricKey := GetAsymmetricKeyFromId(ctx, connection, keyId, true)
+		if logging.HasError(ctx) {
+			return
+		}
+		query += fmt.Sprintf(" from asymmetric key %s", quoteIdentifier(asymmetricKey.Name))
 	}
 
 	if connection.Provider == "fabric" {
@@ -174,7 +220,7 @@ func GetUserFromName(ctx context.Context, connection Connection, name string) (u
 		Name:           name,
 		PrincipalId:    id,
 		Type:           describeUserType(ctx, userType),
-		Authentication: describeAuthentication(ctx, authentication_type),
+		Authentication: describeUserAuthentication(ctx, userType, authentication_type),
 		Sid:            sid,
 		DefaultSchema:  parseNullString(defaultSchema),
 	}
@@ -236,7 +282,7 @@ func GetUserFromPrincipalId(ctx context.Context, connection Connection, principa
 		Name:           name,
 		PrincipalId:    principalId,
 		Type:           describeUserType(ctx, userType),
-		Authentication: describeAuthentication(ctx, authentication_type),
+		Authentication: describeUserAuthentication(ctx, userType, authentication_type),
 		Sid:            sid,
 		DefaultSchema:  parseNullString(defaultSchema),
 	}
@@ -263,10 +309,6 @@ func SetUserDefaultSchemaName(ctx context.Context, connection Connection, userNa
 	}
 }
 
-func quoteIdentifier(identifier string) string {
-	return "[" + strings.ReplaceAll(identifier, "]", "]]") + "]"
-}
-
 func GetEntraIDIdentifierFromPrincipalId(ctx context.Context, connection Connection, principalId int64) string {
 
 	var entraid_identifier string
@@ -290,13 +332,18 @@ func GetEntraIDIdentifierFromPrincipalId(ctx context.Context, connection Connect
 	return entraid_identifier
 }
 
-func DropUser(ctx context.Context, connection Connection, principalId int64) {
+func DropUser(ctx context.Context, connection Connection, principalId int64, onDestroy string, reassignOwnedTo string) {
 
 	user := GetUserFromPrincipalId(ctx, connection, principalId)
 	if logging.HasError(ctx) || user.Id == "" {
 		return
 	}
 
+	prepareDropPrincipal(ctx, connection, Principal{Name: user.Name, PrincipalId: user.PrincipalId}, onDestroy, reassignOwnedTo)
+	if logging.HasError(ctx) {
+		return
+	}
+
 	query := fmt.Sprintf(`
 		IF EXISTS (SELECT 1 FROM sys.database_principals WHERE name = '%[1]s')
 		BEGIN
@@ -310,3 +357,61 @@ func DropUser(ctx context.Context, connection Connection, principalId int64) {
 		logging.AddError(ctx, fmt.Sprintf("Dropping user %s failed", user.Name), err)
 	}
 }
+
+// Renaming keeps the principal id, so permissions and role memberships are kept.
+func UpdateUserName(ctx context.Context, connection Connection, principalId int64, name string) {
+	user := GetUserFromPrincipalId(ctx, connection, principalId)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	if user.Id == "" {
+		logging.AddError(ctx, "User not found", fmt.Sprintf("User with principal id %d doesn't exist", principalId))
+		return
+	}
+
+	query := fmt.Sprintf("alter user %s with name = %s", quoteIdentifier(user.Name), quoteIdentifier(name))
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Renaming user %s to %s failed", user.Name, name), err)
+	}
+}
+
+func UpdateUserPassword(ctx context.Context, connection Connection, name string, password string) {
+	query := fmt.Sprintf("alter user %s with password = %s", quoteIdentifier(name), quoteString(password))
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Changing password of user %s failed", name), err)
+	}
+}
+
+// Remaps the user to another login, e.g. an orphaned user after moving the database to another server.
+func UpdateUserLogin(ctx context.Context, connection Connection, name string, loginId string) {
+	login := parseUserLoginId(ctx, connection, loginId)
+	if logging.HasError(ctx) {
+		return
+	}
+
+	query := fmt.Sprintf("alter user %s with login = %s", quoteIdentifier(name), quoteIdentifier(login.Name))
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Remapping user %s to login %s failed", name, login.Name), err)
+	}
+}
+
+// Converts a user mapped to a SQL login to a contained user with the password of the login.
+// The login is kept, so it can still be used by other databases.
+// sp_migrate_user_to_contained only exists in SQL Server and Azure SQL Managed Instance.
+func MigrateUserToContained(ctx context.Context, connection Connection, name string) {
+	azureSQLDatabase := isAzureSQLDatabase(ctx, connection)
+	if logging.HasError(ctx) {
+		return
+	}
+	if azureSQLDatabase {
+		logging.AddError(ctx, "Invalid config",
+			fmt.Sprintf("Migrating user %s from `SQLLogin` to `DBSQLLogin` is only supported in SQL Server and Azure SQL Managed Instance. Azure SQL Database doesn't support `sp_migrate_user_to_contained`, recreate the user instead.", name))
+		return
+	}
+
+	query := "exec sp_migrate_user_to_contained @username = @name, @rename = N'keep_name', @disablelogin = N'do_not_disable_login'"
+	if _, err := connection.Connection.ExecContext(ctx, query, sql.Named("name", name)); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Migrating user %s to a contained user failed", name), err)
+	}
+}
@@ -14,3 +14,18 @@ func TestQuoteIdentifier(t *testing.T) {
 		}
 	}
 }
+
+func TestEntraIDUserType(t *testing.T) {
+	tests := map[string]string{
+		"user":        "E",
+		"application": "E",
+		"group":       "X",
+		"":            "E",
+	}
+
+	for input, expected := range tests {
+		if actual := entraIDUserType(input); actual != expected {
+			t.Errorf("entraIDUserType(%q) = %q, want %q", input, actual, expected)
+		}
+	}
+}
@@ -2,53 +2,98 @@ package sql
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
 	"fmt"
-	"math/rand"
+	"math/big"
 	"strconv"
 	"strings"
 	"terraform-provider-azuresql/internal/logging"
 )
 
 var (
-	lowerCharSet   = "abcdedfghijklmnopqrst"
+	lowerCharSet   = "abcdefghijklmnopqrstuvwxyz"
 	upperCharSet   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	specialCharSet = "!@#$%&*"
 	numberSet      = "0123456789"
 	allCharSet     = lowerCharSet + upperCharSet + specialCharSet + numberSet
 )
 
-func GeneratePassword(passwordLength, minSpecialChar, minNum, minUpperCase int) string {
-	var password strings.Builder
+// Minimum number of characters of each class in a generated password.
+type PasswordPolicy struct {
+	Length         int
+	MinSpecialChar int
+	MinNum         int
+	MinUpperCase   int
+	MinLowerCase   int
+}
+
+// 20 characters with at least 3 special characters, 4 numbers and 5 upper case letters.
+var DefaultPasswordPolicy = PasswordPolicy{
+	Length:         20,
+	MinSpecialChar: 3,
+	MinNum:         4,
+	MinUpperCase:   5,
+}
+
+// Cryptographically secure random integer in [0, max).
+func randomInt(max int) (int, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(max)))
+	if err != nil {
+		return 0, err
+	}
+	return int(n.Int64()), nil
+}
+
+func randomChars(password []byte, count int, charSet string) ([]byte, error) {
+	for i := 0; i < count; i++ {
+		n, err := randomInt(len(charSet))
+		if err != nil {
+			return password, err
+		}
+		password = append(password, charSet[n])
+	}
+	return password, nil
+}
 
-	//Set special character
-	for i := 0; i < minSpecialChar; i++ {
-		random := rand.Intn(len(specialCharSet))
-		password.WriteString(string(specialCharSet[random]))
+func GeneratePassword(ctx context.Context, policy PasswordPolicy) string {
+	required := policy.MinSpecialChar + policy.MinNum + policy.MinUpperCase + policy.MinLowerCase
+	if policy.Length <= 0 || required > policy.Length {
+		logging.AddError(ctx, "Invalid password policy", fmt.Sprintf("Password length %d should be positive and at least the sum of the minimum characters per class (%d)", policy.Length, required))
+		return ""
 	}
 
-	//Set numeric
-	for i := 0; i < minNum; i++ {
-		random := rand.Intn(len(numberSet))
-		password.WriteString(string(numberSet[random]))
+	classes := []struct {
+		count   int
+		charSet string
+	}{
+		{policy.MinSpecialChar, specialCharSet},
+		{policy.MinNum, numberSet},
+		{policy.MinUpperCase, upperCharSet},
+		{policy.MinLowerCase, lowerCharSet},
+		{policy.Length - required, allCharSet},
 	}
 
-	//Set uppercase
-	for i := 0; i < minUpperCase; i++ {
-		random := rand.Intn(len(upperCharSet))
-		password.WriteString(string(upperCharSet[random]))
+	var err error
+	password := make([]byte, 0, policy.Length)
+	for _, class := range classes {
+		if password, err = randomChars(password, class.count, class.charSet); err != nil {
+			logging.AddError(ctx, "Password generation failed", err)
+			return ""
+		}
 	}
 
-	remainingLength := passwordLength - minSpecialChar - minNum - minUpperCase
-	for i := 0; i < remainingLength; i++ {
-		random := rand.Intn(len(allCharSet))
-		password.WriteString(string(allCharSet[random]))
+	// Fisher-Yates shuffle, so the required characters aren't at the start
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomInt(i + 1)
+		if err != nil {
+			logging.AddError(ctx, "Password generation failed", err)
+			return ""
+		}
+		password[i], password[j] = password[j], password[i]
 	}
-	inRune := []rune(password.String())
-	rand.Shuffle(len(inRune), func(i, j int) {
-		inRune[i], inRune[j] = inRune[j], inRune[i]
-	})
-	return string(inRune)
+
+	return string(password)
 }
 
 func NewNullString(s string) sql.NullString {
@@ -69,6 +114,16 @@ func parseNullString(s sql.NullString) string {
 	}
 }
 
+// Quote an identifier for use in a query, doubling embedded closing brackets.
+func quoteIdentifier(identifier string) string {
+	return "[" + strings.ReplaceAll(identifier, "]", "]]") + "]"
+}
+
+// Quote a string literal for use in a query, doubling embedded single quotes.
+func quoteString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
 func PairwiseReverseHex(number int64, parts int) string {
 	result := ""
 
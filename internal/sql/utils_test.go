@@ -1,6 +1,7 @@
 package sql
 
 import (
+	"strings"
 	"terraform-provider-azuresql/internal/logging"
 	"testing"
 
@@ -22,3 +23,33 @@ func TestObjectIDToDatabaseSID(t *testing.T) {
 	ctx := logging.GetTestContext()
 	assert.Equal(t, ObjectIDToDatabaseSID(ctx, "09dea0f2-1e99-4107-a985-111111111111"), "0xF2A0DE09991E0741A985111111111111")
 }
+
+func TestGeneratePassword(t *testing.T) {
+	ctx := logging.GetTestContext()
+	policy := PasswordPolicy{Length: 30, MinSpecialChar: 4, MinNum: 5, MinUpperCase: 6, MinLowerCase: 7}
+
+	password := GeneratePassword(ctx, policy)
+	assert.False(t, logging.HasError(ctx))
+	assert.Len(t, password, 30)
+
+	count := func(charSet string) (n int) {
+		for _, c := range password {
+			if strings.ContainsRune(charSet, c) {
+				n++
+			}
+		}
+		return
+	}
+	assert.GreaterOrEqual(t, count(specialCharSet), 4)
+	assert.GreaterOrEqual(t, count(numberSet), 5)
+	assert.GreaterOrEqual(t, count(upperCharSet), 6)
+	assert.GreaterOrEqual(t, count(lowerCharSet), 7)
+
+	assert.NotEqual(t, password, GeneratePassword(ctx, policy), "Generated passwords should differ.")
+}
+
+func TestGeneratePasswordInvalidPolicy(t *testing.T) {
+	ctx := logging.GetTestContext()
+	GeneratePassword(ctx, PasswordPolicy{Length: 5, MinNum: 3, MinUpperCase: 3})
+	assert.True(t, logging.HasError(ctx))
+}
@@ -62,6 +62,25 @@ func CreateViewFromDefinition(ctx context.Context, connection Connection, name s
 		return
 	}
 
+	query := buildViewQuery("create", schema.Name, name, definition, schemabinding, checkOption)
+
+	_, err := connection.Connection.ExecContext(ctx, query)
+
+	if err != nil {
+		logging.AddError(ctx, "View creation failed", err)
+		return
+	}
+
+	view = GetViewFromNameAndSchema(ctx, connection, name, schemaResourceId, false)
+	if !logging.HasError(ctx) && view.Id == "" {
+		logging.AddError(ctx, "Unable to read newly created view", fmt.Sprintf("Unable to read view %s after creation.", name))
+	}
+
+	return view
+}
+
+// Statement is either create or alter
+func buildViewQuery(statement string, schemaName string, name string, definition string, schemabinding bool, checkOption bool) string {
 	arg_schemabinding := ""
 	if schemabinding {
 		arg_schemabinding = "with schemabinding"
@@ -72,26 +91,31 @@ func CreateViewFromDefinition(ctx context.Context, connection Connection, name s
 		arg_checkoption = "with check option"
 	}
 
-	query := fmt.Sprintf(`
-create view %s.%s %s as (
+	return fmt.Sprintf(`
+%s view %s.%s %s as (
 	%s
 )
 %s
-	`, schema.Name, name, arg_schemabinding, definition, arg_checkoption)
-
-	_, err := connection.Connection.ExecContext(ctx, query)
+	`, statement, schemaName, name, arg_schemabinding, definition, arg_checkoption)
+}
 
-	if err != nil {
-		logging.AddError(ctx, "View creation failed", err)
+// Alters the view to the given definition and options, e.g. to update the definition in sys.sql_modules after a rename.
+func AlterView(ctx context.Context, connection Connection, id string, definition string, schemabinding bool, checkOption bool) {
+	view := GetViewFromId(ctx, connection, id, true)
+	if logging.HasError(ctx) {
 		return
 	}
 
-	view = GetViewFromNameAndSchema(ctx, connection, name, schemaResourceId, false)
-	if !logging.HasError(ctx) && view.Id == "" {
-		logging.AddError(ctx, "Unable to read newly created view", fmt.Sprintf("Unable to read view %s after creation.", name))
+	schema := GetSchemaFromId(ctx, connection, view.Schema, true)
+	if logging.HasError(ctx) {
+		return
 	}
 
-	return view
+	query := buildViewQuery("alter", schema.Name, view.Name, definition, schemabinding, checkOption)
+
+	if _, err := connection.Connection.ExecContext(ctx, query); err != nil {
+		logging.AddError(ctx, fmt.Sprintf("Altering view %s.%s failed", schema.Name, view.Name), err)
+	}
 }
 
 func cleanDefinition(definition string) string {
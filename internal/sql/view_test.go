@@ -2,6 +2,7 @@ package sql
 
 import (
 	"fmt"
+	"strings"
 	"terraform-provider-azuresql/internal/logging"
 	"testing"
 )
@@ -21,3 +22,16 @@ func TestExtractViewDefintion(t *testing.T) {
 		t.Errorf("Parsed view definition does not match original definition, %s, %s", definition, parsed)
 	}
 }
+
+func TestBuildAlterViewQuery(t *testing.T) {
+	ctx := logging.GetTestContext()
+
+	query := buildViewQuery("alter", "dbo", "recent_orders", "select * from orders", true, false)
+	if !strings.Contains(query, "alter view dbo.recent_orders with schemabinding as (") {
+		t.Errorf("Unexpected alter view statement %s", query)
+	}
+
+	if parsed := extractViewDefintion(ctx, query); parsed != "select * from orders" {
+		t.Errorf("Parsed view definition does not match original definition, %s", parsed)
+	}
+}